@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 
 	"k8s.io/client-go/kubernetes"
@@ -146,3 +147,21 @@ func MakeDynamicClient(apiConf APIConfig) (dynamic.Interface, error) {
 
 	return client, nil
 }
+
+func MakeDiscoveryClient(apiConf APIConfig) (discovery.DiscoveryInterface, error) {
+	if err := apiConf.Validate(); err != nil {
+		return nil, err
+	}
+
+	authConf, err := CreateRestConfig(apiConf)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := discovery.NewDiscoveryClientForConfig(authConf)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,109 @@
+package kymastatsreceiver
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
+)
+
+// clusterUIDNamespace is the namespace whose UID identifies the cluster, as it exists in every cluster and is never deleted.
+const clusterUIDNamespace = "kube-system"
+
+var (
+	namespacesGVR = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	configMapsGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+)
+
+// clusterIdentity resolves the identity of the cluster, which is attached to every emitted resource. The identity
+// is retrieved from the API server until it has been resolved once, and is cached afterwards.
+type clusterIdentity struct {
+	dynamic dynamic.Interface
+	config  ClusterNameConfig
+	logger  *zap.Logger
+
+	resolveUID  bool
+	resolveName bool
+
+	uid  string
+	name string
+}
+
+func newClusterIdentity(dynamic dynamic.Interface, mbc metadata.MetricsBuilderConfig, config ClusterNameConfig, logger *zap.Logger) *clusterIdentity {
+	return &clusterIdentity{
+		dynamic:     dynamic,
+		config:      config,
+		logger:      logger,
+		resolveUID:  mbc.ResourceAttributes.K8sClusterUID.Enabled,
+		resolveName: mbc.ResourceAttributes.K8sClusterName.Enabled,
+		name:        config.Value,
+	}
+}
+
+// resolve retrieves the parts of the cluster identity that are enabled and not resolved yet. Errors are logged,
+// the identity is retried on the next scrape.
+func (c *clusterIdentity) resolve(ctx context.Context) {
+	if c.resolveUID && c.uid == "" {
+		uid, err := c.getUID(ctx)
+		if err != nil {
+			c.logger.Warn("Error retrieving the cluster UID", zap.Error(err))
+		}
+
+		c.uid = uid
+	}
+
+	if c.resolveName && c.name == "" && c.config.ConfigMap != nil {
+		name, err := c.getName(ctx)
+		if err != nil {
+			c.logger.Warn("Error retrieving the cluster name", zap.Error(err))
+		}
+
+		c.name = name
+	}
+}
+
+func (c *clusterIdentity) getUID(ctx context.Context) (string, error) {
+	ns, err := c.dynamic.Resource(namespacesGVR).Get(ctx, clusterUIDNamespace, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	return string(ns.GetUID()), nil
+}
+
+func (c *clusterIdentity) getName(ctx context.Context) (string, error) {
+	ref := c.config.ConfigMap
+
+	cm, err := c.dynamic.Resource(configMapsGVR).Namespace(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	name, found, err := unstructured.NestedString(cm.Object, "data", ref.Key)
+	if err != nil {
+		return "", err
+	}
+
+	if !found || name == "" {
+		return "", fmt.Errorf("key %q not found in ConfigMap %s/%s", ref.Key, ref.Namespace, ref.Name)
+	}
+
+	return name, nil
+}
+
+// setAttributes adds the resolved cluster identity to a resource.
+func (c *clusterIdentity) setAttributes(rb *metadata.ResourceBuilder) {
+	if c.uid != "" {
+		rb.SetK8sClusterUID(c.uid)
+	}
+
+	if c.name != "" {
+		rb.SetK8sClusterName(c.name)
+	}
+}
@@ -2,9 +2,19 @@ package kymastatsreceiver
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 
 	"github.com/kyma-project/opentelemetry-collector-components/internal/k8sconfig"
@@ -20,17 +30,213 @@ type Config struct {
 	Resources        []ResourceConfig `mapstructure:"resources"`
 	K8sLeaderElector *component.ID    `mapstructure:"k8s_leader_elector"`
 
+	// ResourcesConfigMap references a ConfigMap key holding additional resources in the format of the receiver config.
+	// The ConfigMap is watched, so that the collected resources are updated without restarting the collector.
+	ResourcesConfigMap *ConfigMapKeyConfig `mapstructure:"resources_config_map"`
+
+	// Mode defines how resources are retrieved from the API server. In pull mode, resources are listed on
+	// every scrape. In watch mode, an informer per resource keeps a local cache up to date, which is read on every scrape.
+	Mode Mode `mapstructure:"mode"`
+
+	// PageSize limits the number of objects returned by a single list request in pull mode. Larger result sets
+	// are retrieved page by page. A page size of 0 disables pagination.
+	PageSize int64 `mapstructure:"page_size"`
+
+	// MaxConcurrentRequests limits the number of resources that are listed concurrently during a scrape.
+	MaxConcurrentRequests int `mapstructure:"max_concurrent_requests"`
+
+	// StateSet reports every known state of a resource in the `kyma.resource.status.state` metric.
+	StateSet StateSetConfig `mapstructure:"state_set"`
+
+	// Conditions defines how the status conditions of a resource are converted.
+	Conditions ConditionsConfig `mapstructure:"conditions"`
+
+	// Discovery finds additional resources by listing the CustomResourceDefinitions of the cluster.
+	Discovery DiscoveryConfig `mapstructure:"discovery"`
+
+	// Labels lists glob patterns of object labels, which are added as `k8s.resource.label.<key>` resource attributes.
+	Labels []string `mapstructure:"labels"`
+	// Annotations lists glob patterns of object annotations, which are added as `k8s.resource.annotation.<key>` resource attributes.
+	Annotations []string `mapstructure:"annotations"`
+
+	// ClusterName defines the source of the `k8s.cluster.name` resource attribute.
+	ClusterName ClusterNameConfig `mapstructure:"cluster_name"`
+
+	// Sharding splits the resource objects between multiple replicas, as an alternative to leader election.
+	Sharding ShardingConfig `mapstructure:"sharding"`
+
+	// EntityEvents emits every resource object as an entity in logs pipelines.
+	EntityEvents EntityEventsConfig `mapstructure:"entity_events"`
+
 	// Used for unit testing only
-	makeDynamicClient func() (dynamic.Interface, error)
+	makeDynamicClient   func() (dynamic.Interface, error)
+	makeDiscoveryClient func() (discovery.DiscoveryInterface, error)
+}
+
+type Mode string
+
+const (
+	ModePull  Mode = "pull"
+	ModeWatch Mode = "watch"
+)
+
+type DiscoveryConfig struct {
+	// Groups selects the API groups of the discovered resources. A group either matches exactly, or by suffix
+	// if it starts with `*.`, for example `*.kyma-project.io`.
+	Groups []string `mapstructure:"groups"`
+	// LabelSelector selects the CustomResourceDefinitions of the discovered resources by label.
+	LabelSelector string `mapstructure:"label_selector"`
+	// Interval defines how often discovery is re-run.
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type StateSetConfig struct {
+	// Enabled emits a `kyma.resource.status.state` data point for every known state, which is 1 for the current state
+	// and 0 for all others. By default, only the current state is emitted.
+	Enabled bool `mapstructure:"enabled"`
+	// States lists the known states.
+	States []string `mapstructure:"states"`
+}
+
+type ConditionsConfig struct {
+	// Strict drops conditions without a reason. By default, a missing reason is reported as an empty reason.
+	Strict bool `mapstructure:"strict"`
+	// MessageMaxLength limits the length of the opt-in `message` attribute. Longer messages are truncated and
+	// suffixed with a hash of the full message, so that different messages stay distinguishable.
+	MessageMaxLength int `mapstructure:"message_max_length"`
+}
+
+type ClusterNameConfig struct {
+	// Value is a static cluster name.
+	Value string `mapstructure:"value"`
+	// ConfigMap reads the cluster name from a key of a ConfigMap.
+	ConfigMap *ConfigMapKeyConfig `mapstructure:"config_map"`
+}
+
+type ConfigMapKeyConfig struct {
+	Namespace string `mapstructure:"namespace"`
+	Name      string `mapstructure:"name"`
+	Key       string `mapstructure:"key"`
+}
+
+type EntityEventsConfig struct {
+	// Enabled emits an entity state event for every resource object on every scrape, and an entity delete event
+	// once the object is gone.
+	Enabled bool `mapstructure:"enabled"`
+}
+
+type ShardingKey string
+
+const (
+	// ShardingKeyUID assigns every object to a replica by its UID.
+	ShardingKeyUID ShardingKey = "uid"
+	// ShardingKeyNamespace assigns all objects of a namespace to the same replica. Cluster-scoped objects are
+	// assigned by their UID.
+	ShardingKeyNamespace ShardingKey = "namespace"
+)
+
+type ShardingConfig struct {
+	// Replicas is the number of replicas sharing the resource objects. Sharding is disabled if not set.
+	Replicas int `mapstructure:"replicas"`
+	// Ordinal is the index of this replica, from 0 to replicas-1. If not set, it is parsed from the trailing
+	// number of the hostname, which is the pod name of a StatefulSet, for example `otel-collector-2`.
+	Ordinal *int `mapstructure:"ordinal"`
+	// Key defines how the objects are assigned to the replicas.
+	Key ShardingKey `mapstructure:"key"`
 }
 
 type ResourceConfig struct {
 	Group    string `mapstructure:"group"`
 	Version  string `mapstructure:"version"`
 	Resource string `mapstructure:"resource"`
+
+	// Namespaces restricts the namespaces the resource is collected from. By default, the resource is collected cluster-wide.
+	Namespaces NamespacesConfig `mapstructure:"namespaces"`
+	// LabelSelector is passed to the API server to only collect resources with matching labels.
+	LabelSelector string `mapstructure:"label_selector"`
+	// FieldSelector is passed to the API server to only collect resources with matching fields.
+	FieldSelector string `mapstructure:"field_selector"`
+	// Fields defines additional fields of the resource, which are extracted into metrics.
+	Fields []FieldConfig `mapstructure:"fields"`
+}
+
+type NamespacesConfig struct {
+	// Include collects the resource only from the listed namespaces.
+	Include []string `mapstructure:"include"`
+	// Exclude collects the resource from all namespaces except the listed ones.
+	Exclude []string `mapstructure:"exclude"`
+}
+
+type FieldType string
+
+const (
+	// FieldTypeGauge emits the value of a numeric or boolean field as a gauge.
+	FieldTypeGauge FieldType = "gauge"
+	// FieldTypeStateSet emits the value of a string field as a state attribute of a gauge with value 1.
+	FieldTypeStateSet FieldType = "state_set"
+)
+
+const defaultStateAttribute = "state"
+
+// builtInMetricNames are the metrics of the receiver, which a field metric must not be named after.
+var builtInMetricNames = []string{
+	metadata.MetricsInfo.KymaModuleStatusState.Name,
+	metadata.MetricsInfo.KymaReceiverLeader.Name,
+	metadata.MetricsInfo.KymaResourceConditionCount.Name,
+	metadata.MetricsInfo.KymaResourceCount.Name,
+	metadata.MetricsInfo.KymaResourceDeletionPending.Name,
+	metadata.MetricsInfo.KymaResourceScrapeErrors.Name,
+	metadata.MetricsInfo.KymaResourceStatusConditionLastTransition.Name,
+	metadata.MetricsInfo.KymaResourceStatusConditions.Name,
+	metadata.MetricsInfo.KymaResourceStatusGenerationLag.Name,
+	metadata.MetricsInfo.KymaResourceStatusReconciled.Name,
+	metadata.MetricsInfo.KymaResourceStatusState.Name,
+}
+
+// fieldResourceAttributes identify the resource on every data point of a field metric, so field attributes
+// must not use their names.
+var fieldResourceAttributes = []string{"group", "kind", "name", "namespace", "version"}
+
+type FieldConfig struct {
+	// Path is a JSONPath-like expression selecting the field, for example `status.readyReplicas` or `status.modules[*].state`.
+	Path string `mapstructure:"path"`
+	// Metric is the name of the emitted metric.
+	Metric      string `mapstructure:"metric"`
+	Description string `mapstructure:"description"`
+	Unit        string `mapstructure:"unit"`
+	// Type is either `gauge` for numeric and boolean fields, or `state_set` for string fields.
+	Type FieldType `mapstructure:"type"`
+	// States lists the known values of a `state_set` field. If set, every known state gets a data point,
+	// which is 1 for the current value and 0 for all others.
+	States []string `mapstructure:"states"`
+	// StateAttribute is the name of the attribute carrying the value of a `state_set` field. Defaults to `state`.
+	StateAttribute string `mapstructure:"state_attribute"`
+	// Attributes are additional data point attributes extracted from the resource.
+	Attributes []FieldAttributeConfig `mapstructure:"attributes"`
+}
+
+type FieldAttributeConfig struct {
+	// Name is the name of the data point attribute.
+	Name string `mapstructure:"name"`
+	// Path selects the attribute value. It is relative to the list element matched by the last `[*]` wildcard
+	// of the field path, or to the resource if the field path has no wildcard.
+	Path string `mapstructure:"path"`
 }
 
-var errEmptyResources = errors.New("empty resources")
+var (
+	errEmptyResources                   = errors.New("empty resources")
+	errNamespacesIncludeAndExclude      = errors.New("namespaces include and exclude are mutually exclusive")
+	errDiscoveryIntervalNotPositive     = errors.New("discovery: interval must be positive")
+	errNegativePageSize                 = errors.New("page_size must not be negative")
+	errEmptyPattern                     = errors.New("pattern must not be empty")
+	errMaxConcurrentRequestsNotPositive = errors.New("max_concurrent_requests must be positive")
+	errEmptyStates                      = errors.New("state_set: states must not be empty")
+	errClusterNameValueAndConfigMap     = errors.New("cluster_name: value and config_map are mutually exclusive")
+	errConfigMapKeyIncomplete           = errors.New("namespace, name, and key must be set")
+	errNegativeShardingReplicas         = errors.New("sharding: replicas must not be negative")
+	errMessageMaxLengthNotPositive      = errors.New("conditions: message_max_length must be positive")
+	errShardingAndLeaderElection        = errors.New("sharding and k8s_leader_elector are mutually exclusive")
+)
 
 func (cfg *Config) Validate() error {
 	if err := cfg.APIConfig.Validate(); err != nil {
@@ -41,13 +247,312 @@ func (cfg *Config) Validate() error {
 		return err
 	}
 
-	if len(cfg.Resources) == 0 {
+	if len(cfg.Resources) == 0 && !cfg.Discovery.enabled() && cfg.ResourcesConfigMap == nil {
 		return errEmptyResources
 	}
 
+	if cfg.ResourcesConfigMap != nil {
+		if err := cfg.ResourcesConfigMap.Validate(); err != nil {
+			return fmt.Errorf("resources_config_map: %w", err)
+		}
+	}
+
+	if err := cfg.Discovery.Validate(); err != nil {
+		return err
+	}
+
+	if err := cfg.StateSet.Validate(); err != nil {
+		return err
+	}
+
+	if cfg.Conditions.MessageMaxLength <= 0 {
+		return errMessageMaxLengthNotPositive
+	}
+
+	if err := cfg.ClusterName.Validate(); err != nil {
+		return err
+	}
+
+	if err := cfg.Sharding.Validate(); err != nil {
+		return err
+	}
+
+	if cfg.Sharding.enabled() && cfg.K8sLeaderElector != nil {
+		return errShardingAndLeaderElection
+	}
+
+	for _, resource := range cfg.Resources {
+		if err := resource.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if slices.Contains(cfg.Labels, "") {
+		return fmt.Errorf("labels: %w", errEmptyPattern)
+	}
+
+	if slices.Contains(cfg.Annotations, "") {
+		return fmt.Errorf("annotations: %w", errEmptyPattern)
+	}
+
+	if cfg.PageSize < 0 {
+		return errNegativePageSize
+	}
+
+	if cfg.MaxConcurrentRequests <= 0 {
+		return errMaxConcurrentRequestsNotPositive
+	}
+
+	switch cfg.Mode {
+	case ModePull, ModeWatch:
+	default:
+		return fmt.Errorf("invalid mode %q, valid modes: [%s, %s]", cfg.Mode, ModePull, ModeWatch)
+	}
+
+	return nil
+}
+
+func (dc DiscoveryConfig) Validate() error {
+	if !dc.enabled() {
+		return nil
+	}
+
+	for _, group := range dc.Groups {
+		if group == "" || strings.Contains(strings.TrimPrefix(group, "*."), "*") {
+			return fmt.Errorf("discovery: invalid group %q, only a leading `*.` wildcard is supported", group)
+		}
+	}
+
+	if _, err := labels.Parse(dc.LabelSelector); err != nil {
+		return fmt.Errorf("discovery: invalid label selector: %w", err)
+	}
+
+	if dc.Interval <= 0 {
+		return errDiscoveryIntervalNotPositive
+	}
+
+	return nil
+}
+
+func (sc StateSetConfig) Validate() error {
+	if !sc.Enabled {
+		return nil
+	}
+
+	if len(sc.States) == 0 {
+		return errEmptyStates
+	}
+
+	for i, state := range sc.States {
+		if state == "" {
+			return errors.New("state_set: state must not be empty")
+		}
+
+		if slices.Contains(sc.States[:i], state) {
+			return fmt.Errorf("state_set: duplicate state %q", state)
+		}
+	}
+
+	return nil
+}
+
+func (cc ClusterNameConfig) Validate() error {
+	if cc.ConfigMap == nil {
+		return nil
+	}
+
+	if cc.Value != "" {
+		return errClusterNameValueAndConfigMap
+	}
+
+	if err := cc.ConfigMap.Validate(); err != nil {
+		return fmt.Errorf("cluster_name: config_map: %w", err)
+	}
+
+	return nil
+}
+
+func (cc ConfigMapKeyConfig) Validate() error {
+	if cc.Namespace == "" || cc.Name == "" || cc.Key == "" {
+		return errConfigMapKeyIncomplete
+	}
+
+	return nil
+}
+
+func (sc ShardingConfig) Validate() error {
+	if sc.Replicas < 0 {
+		return errNegativeShardingReplicas
+	}
+
+	if !sc.enabled() {
+		return nil
+	}
+
+	if sc.Ordinal != nil && (*sc.Ordinal < 0 || *sc.Ordinal >= sc.Replicas) {
+		return fmt.Errorf("sharding: ordinal %d out of range, must be between 0 and %d", *sc.Ordinal, sc.Replicas-1)
+	}
+
+	switch sc.Key {
+	case ShardingKeyUID, ShardingKeyNamespace:
+	default:
+		return fmt.Errorf("sharding: invalid key %q, valid keys: [%s, %s]", sc.Key, ShardingKeyUID, ShardingKeyNamespace)
+	}
+
+	return nil
+}
+
+// enabled reports whether sharding is configured, which requires a number of replicas.
+func (sc ShardingConfig) enabled() bool {
+	return sc.Replicas > 0
+}
+
+// enabled reports whether discovery is configured, which requires at least one group or a label selector.
+func (dc DiscoveryConfig) enabled() bool {
+	return len(dc.Groups) > 0 || dc.LabelSelector != ""
+}
+
+func (rc ResourceConfig) Validate() error {
+	if len(rc.Namespaces.Include) > 0 && len(rc.Namespaces.Exclude) > 0 {
+		return fmt.Errorf("resource %s: %w", rc.gvr(), errNamespacesIncludeAndExclude)
+	}
+
+	if _, err := labels.Parse(rc.LabelSelector); err != nil {
+		return fmt.Errorf("resource %s: invalid label selector: %w", rc.gvr(), err)
+	}
+
+	if _, err := fields.ParseSelector(rc.FieldSelector); err != nil {
+		return fmt.Errorf("resource %s: invalid field selector: %w", rc.gvr(), err)
+	}
+
+	metrics := make(map[string]bool, len(rc.Fields))
+	for _, field := range rc.Fields {
+		if err := field.Validate(); err != nil {
+			return fmt.Errorf("resource %s: %w", rc.gvr(), err)
+		}
+
+		// the data points of fields sharing a metric name would be mixed up in a single metric
+		if metrics[field.Metric] {
+			return fmt.Errorf("resource %s: field metric %s: name is used by more than one field", rc.gvr(), field.Metric)
+		}
+
+		metrics[field.Metric] = true
+	}
+
 	return nil
 }
 
+func (fc FieldConfig) Validate() error {
+	if fc.Metric == "" {
+		return fmt.Errorf("field %q: metric name must not be empty", fc.Path)
+	}
+
+	if slices.Contains(builtInMetricNames, fc.Metric) {
+		return fmt.Errorf("field metric %s: name collides with a built-in metric", fc.Metric)
+	}
+
+	if _, err := parseFieldPath(fc.Path); err != nil {
+		return fmt.Errorf("field metric %s: %w", fc.Metric, err)
+	}
+
+	switch fc.Type {
+	case FieldTypeGauge, FieldTypeStateSet:
+	default:
+		return fmt.Errorf("field metric %s: invalid type %q, valid types: [%s, %s]", fc.Metric, fc.Type, FieldTypeGauge, FieldTypeStateSet)
+	}
+
+	for i, state := range fc.States {
+		if state == "" {
+			return fmt.Errorf("field metric %s: state must not be empty", fc.Metric)
+		}
+
+		if slices.Contains(fc.States[:i], state) {
+			return fmt.Errorf("field metric %s: duplicate state %s", fc.Metric, state)
+		}
+	}
+
+	if slices.Contains(fieldResourceAttributes, fc.StateAttribute) {
+		return fmt.Errorf("field metric %s: state_attribute %s collides with a resource attribute, reserved attributes: [%s]",
+			fc.Metric, fc.StateAttribute, strings.Join(fieldResourceAttributes, ", "))
+	}
+
+	for _, attr := range fc.Attributes {
+		if attr.Name == "" {
+			return fmt.Errorf("field metric %s: attribute name must not be empty", fc.Metric)
+		}
+
+		if slices.Contains(fieldResourceAttributes, attr.Name) {
+			return fmt.Errorf("field metric %s: attribute %s collides with a resource attribute, reserved attributes: [%s]",
+				fc.Metric, attr.Name, strings.Join(fieldResourceAttributes, ", "))
+		}
+
+		if _, err := parseFieldPath(attr.Path); err != nil {
+			return fmt.Errorf("field metric %s: attribute %s: %w", fc.Metric, attr.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (rc ResourceConfig) gvr() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    rc.Group,
+		Version:  rc.Version,
+		Resource: rc.Resource,
+	}
+}
+
+// namespaces returns the namespaces to list the resource from, an empty namespace means all namespaces.
+func (rc ResourceConfig) namespaces() []string {
+	if len(rc.Namespaces.Include) == 0 {
+		return []string{metav1.NamespaceAll}
+	}
+
+	return rc.Namespaces.Include
+}
+
+// isNamespaceExcluded reports whether objects of the namespace are dropped. The API server already filters them
+// by the field selector, the check only guards against servers that ignore it.
+func (rc ResourceConfig) isNamespaceExcluded(namespace string) bool {
+	return slices.Contains(rc.Namespaces.Exclude, namespace)
+}
+
+// fieldSelector returns the configured field selector, extended by a term for every excluded namespace, so that the
+// objects of excluded namespaces are neither transferred nor cached.
+func (rc ResourceConfig) fieldSelector() string {
+	terms := make([]string, 0, len(rc.Namespaces.Exclude)+1)
+
+	if rc.FieldSelector != "" {
+		terms = append(terms, rc.FieldSelector)
+	}
+
+	for _, namespace := range rc.Namespaces.Exclude {
+		terms = append(terms, fields.OneTermNotEqualSelector("metadata.namespace", namespace).String())
+	}
+
+	return strings.Join(terms, ",")
+}
+
+func (rc ResourceConfig) applyListOptions(opts *metav1.ListOptions) {
+	opts.LabelSelector = rc.LabelSelector
+	opts.FieldSelector = rc.fieldSelector()
+}
+
+// getClients returns the dynamic client and the REST mapper of the configured API server.
+func (cfg *Config) getClients() (dynamic.Interface, meta.RESTMapper, error) {
+	dynamic, err := cfg.getDynamicClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mapper, err := cfg.getRESTMapper()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dynamic, mapper, nil
+}
+
 func (cfg *Config) getDynamicClient() (dynamic.Interface, error) {
 	if cfg.makeDynamicClient != nil {
 		return cfg.makeDynamicClient()
@@ -55,3 +560,25 @@ func (cfg *Config) getDynamicClient() (dynamic.Interface, error) {
 
 	return k8sconfig.MakeDynamicClient(cfg.APIConfig)
 }
+
+// getRESTMapper returns a REST mapper backed by the discovery API. The discovery information is cached, and reset at most
+// every restMapperResetInterval when a resource can't be mapped, for example because its CRD was installed after the
+// receiver started.
+func (cfg *Config) getRESTMapper() (meta.RESTMapper, error) {
+	var (
+		client discovery.DiscoveryInterface
+		err    error
+	)
+
+	if cfg.makeDiscoveryClient != nil {
+		client, err = cfg.makeDiscoveryClient()
+	} else {
+		client, err = k8sconfig.MakeDiscoveryClient(cfg.APIConfig)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newRefreshingRESTMapper(client, restMapperResetInterval), nil
+}
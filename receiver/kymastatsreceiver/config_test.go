@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"k8s.io/utils/ptr"
 
 	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
 )
@@ -33,7 +34,14 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				AuthType:           "serviceAccount",
 				CollectionInterval: duration, InitialDelay: delay,
-				MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
 				Resources: []ResourceConfig{
 					{
 						Group:    "operator.kyma-project.io",
@@ -49,7 +57,14 @@ func TestLoadConfig(t *testing.T) {
 				AuthType:           "kubeConfig",
 				Context:            "k8s-context",
 				CollectionInterval: 30 * time.Second, InitialDelay: delay,
-				MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
 				Resources: []ResourceConfig{
 					{
 						Group:    "operator.kyma-project.io",
@@ -64,7 +79,14 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				AuthType:           "serviceAccount",
 				CollectionInterval: 10 * time.Second, InitialDelay: delay,
-				MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
 				Resources: []ResourceConfig{
 					{
 						Group:    "operator.kyma-project.io",
@@ -87,7 +109,14 @@ func TestLoadConfig(t *testing.T) {
 			expected: &Config{
 				AuthType:           "none",
 				CollectionInterval: duration, InitialDelay: delay,
-				MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
 				Resources: []ResourceConfig{
 					{
 						Group:    "operator.kyma-project.io",
@@ -101,6 +130,445 @@ func TestLoadConfig(t *testing.T) {
 			id:        component.NewIDWithName(metadata.Type, "noresourcegroups"),
 			expectErr: true,
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "watch"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModeWatch,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "telemetries",
+					},
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidmode"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "selectors"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "telemetries",
+						Namespaces: NamespacesConfig{
+							Include: []string{"kyma-system"},
+						},
+						LabelSelector: "app.kubernetes.io/managed-by=kyma",
+						FieldSelector: "metadata.name=default",
+					},
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidlabelselector"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidnamespaces"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "fields"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "kymas",
+						Fields: []FieldConfig{
+							{
+								Path:        "status.modules[*].state",
+								Metric:      "kyma.module.state",
+								Description: "State of the Kyma module.",
+								Type:        FieldTypeStateSet,
+								States:      []string{"Ready", "Error"},
+								Attributes: []FieldAttributeConfig{
+									{Name: "module", Path: "name"},
+								},
+							},
+							{
+								Path:   "spec.replicas",
+								Metric: "kyma.resource.replicas",
+								Unit:   "{replica}",
+								Type:   FieldTypeGauge,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidfieldpath"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidfieldtype"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "fieldmetricbuiltin"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "fieldattributereserved"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "fieldstateattributereserved"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "fieldmetricduplicate"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "fieldstateempty"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "fieldstateduplicate"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "discovery"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                 ModePull,
+				Discovery: DiscoveryConfig{
+					Groups:        []string{"*.kyma-project.io"},
+					LabelSelector: "kyma-project.io/module",
+					Interval:      10 * time.Minute,
+				},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invaliddiscoverygroup"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invaliddiscoveryinterval"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "pagesize"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              100,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "telemetries",
+					},
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidpagesize"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "labels"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
+				Labels:                []string{"app.kubernetes.io/*"},
+				Annotations:           []string{"operator.kyma-project.io/managed-by"},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "telemetries",
+					},
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidlabels"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "maxconcurrentrequests"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: 10,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "telemetries",
+					},
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidmaxconcurrentrequests"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "stateset"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet: StateSetConfig{
+					Enabled: true,
+					States:  []string{"Ready", "Error"},
+				},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "telemetries",
+					},
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidstatesetempty"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidstatesetduplicate"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "clustername"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				StateSet:              StateSetConfig{States: defaultStates},
+				ClusterName: ClusterNameConfig{
+					ConfigMap: &ConfigMapKeyConfig{
+						Namespace: "kyma-system",
+						Name:      "shoot-info",
+						Key:       "shootName",
+					},
+				},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "telemetries",
+					},
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidclusternamevalueandconfigmap"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidclusternameconfigmapincomplete"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "sharding"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				StateSet:              StateSetConfig{States: defaultStates},
+				Sharding: ShardingConfig{
+					Replicas: 3,
+					Ordinal:  ptr.To(1),
+					Key:      ShardingKeyNamespace,
+				},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "telemetries",
+					},
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidshardingordinal"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidshardingkey"),
+			expectErr: true,
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidshardingwithleaderelection"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "conditions"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig: func() metadata.MetricsBuilderConfig {
+					mbc := metadata.NewDefaultMetricsBuilderConfig()
+					mbc.Metrics.KymaResourceStatusConditions.EnabledAttributes = []metadata.KymaResourceStatusConditionsMetricAttributeKey{
+						metadata.KymaResourceStatusConditionsMetricAttributeKeyGroup,
+						metadata.KymaResourceStatusConditionsMetricAttributeKeyKind,
+						metadata.KymaResourceStatusConditionsMetricAttributeKeyName,
+						metadata.KymaResourceStatusConditionsMetricAttributeKeyNamespace,
+						metadata.KymaResourceStatusConditionsMetricAttributeKeyReason,
+						metadata.KymaResourceStatusConditionsMetricAttributeKeyStatus,
+						metadata.KymaResourceStatusConditionsMetricAttributeKeyType,
+						metadata.KymaResourceStatusConditionsMetricAttributeKeyVersion,
+						metadata.KymaResourceStatusConditionsMetricAttributeKeyMessage,
+					}
+
+					return mbc
+				}(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				StateSet:              StateSetConfig{States: defaultStates},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				Conditions: ConditionsConfig{
+					Strict:           true,
+					MessageMaxLength: 64,
+				},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "telemetries",
+					},
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidconditionsmessagemaxlength"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "resourcesconfigmap"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				StateSet:              StateSetConfig{States: defaultStates},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				ResourcesConfigMap: &ConfigMapKeyConfig{
+					Namespace: "kyma-system",
+					Name:      "kymastats-resources",
+					Key:       "resources.yaml",
+				},
+			},
+		},
+		{
+			id:        component.NewIDWithName(metadata.Type, "invalidresourcesconfigmapincomplete"),
+			expectErr: true,
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "entityevents"),
+			expected: &Config{
+				AuthType:           "serviceAccount",
+				CollectionInterval: duration, InitialDelay: delay,
+				MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+				Mode:                  ModePull,
+				Discovery:             DiscoveryConfig{Interval: defaultDiscoveryInterval},
+				PageSize:              defaultPageSize,
+				MaxConcurrentRequests: defaultMaxConcurrentRequests,
+				Conditions:            ConditionsConfig{MessageMaxLength: defaultMessageMaxLength},
+				StateSet:              StateSetConfig{States: defaultStates},
+				Sharding:              ShardingConfig{Key: ShardingKeyUID},
+				EntityEvents:          EntityEventsConfig{Enabled: true},
+				Resources: []ResourceConfig{
+					{
+						Group:    "operator.kyma-project.io",
+						Version:  "v1alpha1",
+						Resource: "telemetries",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -125,3 +593,39 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceConfigFieldSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource ResourceConfig
+		expected string
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:     "field selector",
+			resource: ResourceConfig{FieldSelector: "metadata.name=default"},
+			expected: "metadata.name=default",
+		},
+		{
+			name:     "excluded namespaces",
+			resource: ResourceConfig{Namespaces: NamespacesConfig{Exclude: []string{"kube-system", "istio-system"}}},
+			expected: "metadata.namespace!=kube-system,metadata.namespace!=istio-system",
+		},
+		{
+			name: "field selector and excluded namespaces",
+			resource: ResourceConfig{
+				FieldSelector: "metadata.name=default",
+				Namespaces:    NamespacesConfig{Exclude: []string{"kube-system"}},
+			},
+			expected: "metadata.name=default,metadata.namespace!=kube-system",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.resource.fieldSelector())
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package kymastatsreceiver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/dynamic"
+)
+
+var crdGVR = schema.GroupVersionResource{
+	Group:    "apiextensions.k8s.io",
+	Version:  "v1",
+	Resource: "customresourcedefinitions",
+}
+
+var errNoServedVersion = errors.New("no served version")
+
+// resourceDiscoverer finds the resources to collect by listing the CustomResourceDefinitions of the cluster.
+// Discovery is re-run lazily once the configured interval has passed.
+type resourceDiscoverer struct {
+	dynamic dynamic.Interface
+	config  DiscoveryConfig
+	logger  *zap.Logger
+
+	resources     []ResourceConfig
+	lastDiscovery time.Time
+}
+
+func newResourceDiscoverer(dynamic dynamic.Interface, config DiscoveryConfig, logger *zap.Logger) *resourceDiscoverer {
+	return &resourceDiscoverer{
+		dynamic: dynamic,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// discover returns the discovered resources. If rediscovery fails, the previously discovered resources are returned.
+func (d *resourceDiscoverer) discover(ctx context.Context) ([]ResourceConfig, error) {
+	if !d.lastDiscovery.IsZero() && time.Since(d.lastDiscovery) < d.config.Interval {
+		return d.resources, nil
+	}
+
+	resources, err := d.listResources(ctx)
+	if err != nil {
+		if d.lastDiscovery.IsZero() {
+			return nil, fmt.Errorf("failed to discover resources: %w", err)
+		}
+
+		d.logger.Warn("Error rediscovering resources, keeping previously discovered resources", zap.Error(err))
+
+		return d.resources, nil
+	}
+
+	d.logger.Debug("Discovered resources", zap.Int("resources", len(resources)))
+
+	d.resources = resources
+	d.lastDiscovery = time.Now()
+
+	return d.resources, nil
+}
+
+func (d *resourceDiscoverer) listResources(ctx context.Context) ([]ResourceConfig, error) {
+	crds, err := d.dynamic.Resource(crdGVR).List(ctx, metav1.ListOptions{LabelSelector: d.config.LabelSelector})
+	if err != nil {
+		return nil, err
+	}
+
+	var res []ResourceConfig
+
+	for _, crd := range crds.Items {
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		if !d.config.matchesGroup(group) {
+			continue
+		}
+
+		resource, err := crdToResource(crd)
+		if err != nil {
+			d.logger.Debug("Skipping custom resource definition",
+				zap.Error(err),
+				zap.String("name", crd.GetName()),
+			)
+
+			continue
+		}
+
+		res = append(res, resource)
+	}
+
+	return res, nil
+}
+
+// crdToResource returns the resource of a CustomResourceDefinition with its preferred served version.
+func crdToResource(crd unstructured.Unstructured) (ResourceConfig, error) {
+	group, _, err := unstructured.NestedString(crd.Object, "spec", "group")
+	if err != nil {
+		return ResourceConfig{}, err
+	}
+
+	plural, _, err := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+	if err != nil {
+		return ResourceConfig{}, err
+	}
+
+	versions, _, err := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	if err != nil {
+		return ResourceConfig{}, err
+	}
+
+	var served []string
+
+	for _, v := range versions {
+		versionMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name, _, _ := unstructured.NestedString(versionMap, "name")
+		isServed, _, _ := unstructured.NestedBool(versionMap, "served")
+
+		if name != "" && isServed {
+			served = append(served, name)
+		}
+	}
+
+	if len(served) == 0 {
+		return ResourceConfig{}, errNoServedVersion
+	}
+
+	// the API server prefers the version with the highest priority, e.g. v2 over v1 over v1beta1
+	preferred := slices.MaxFunc(served, version.CompareKubeAwareVersionStrings)
+
+	return ResourceConfig{
+		Group:    group,
+		Version:  preferred,
+		Resource: plural,
+	}, nil
+}
+
+// matchesGroup reports whether the API group matches one of the configured groups. Without configured groups,
+// all groups match.
+func (dc DiscoveryConfig) matchesGroup(group string) bool {
+	if len(dc.Groups) == 0 {
+		return true
+	}
+
+	for _, pattern := range dc.Groups {
+		if suffix, ok := strings.CutPrefix(pattern, "*"); ok {
+			if strings.HasSuffix(group, suffix) {
+				return true
+			}
+
+			continue
+		}
+
+		if group == pattern {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,270 @@
+package kymastatsreceiver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
+)
+
+func TestCRDToResource(t *testing.T) {
+	tests := []struct {
+		name            string
+		versions        []any
+		expectedVersion string
+		expectErr       bool
+	}{
+		{
+			name: "single version",
+			versions: []any{
+				map[string]any{"name": "v1alpha1", "served": true},
+			},
+			expectedVersion: "v1alpha1",
+		},
+		{
+			name: "prefers GA over beta and alpha",
+			versions: []any{
+				map[string]any{"name": "v1alpha1", "served": true},
+				map[string]any{"name": "v1", "served": true},
+				map[string]any{"name": "v1beta2", "served": true},
+			},
+			expectedVersion: "v1",
+		},
+		{
+			name: "ignores versions that are not served",
+			versions: []any{
+				map[string]any{"name": "v2", "served": false},
+				map[string]any{"name": "v1beta1", "served": true},
+			},
+			expectedVersion: "v1beta1",
+		},
+		{
+			name: "no served version",
+			versions: []any{
+				map[string]any{"name": "v1", "served": false},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource, err := crdToResource(*newCRD("telemetries", "operator.kyma-project.io", nil, tt.versions...))
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, ResourceConfig{
+				Group:    "operator.kyma-project.io",
+				Version:  tt.expectedVersion,
+				Resource: "telemetries",
+			}, resource)
+		})
+	}
+}
+
+func TestDiscoveryConfigMatchesGroup(t *testing.T) {
+	tests := []struct {
+		groups   []string
+		group    string
+		expected bool
+	}{
+		{groups: nil, group: "apps", expected: true},
+		{groups: []string{"*.kyma-project.io"}, group: "operator.kyma-project.io", expected: true},
+		{groups: []string{"*.kyma-project.io"}, group: "kyma-project.io", expected: false},
+		{groups: []string{"*.kyma-project.io"}, group: "operator.kyma-project.io.example.com", expected: false},
+		{groups: []string{"telemetry.kyma-project.io"}, group: "telemetry.kyma-project.io", expected: true},
+		{groups: []string{"telemetry.kyma-project.io"}, group: "operator.kyma-project.io", expected: false},
+	}
+
+	for _, tt := range tests {
+		dc := DiscoveryConfig{Groups: tt.groups}
+		require.Equal(t, tt.expected, dc.matchesGroup(tt.group), "groups %v, group %s", tt.groups, tt.group)
+	}
+}
+
+func TestScrape_Discovery(t *testing.T) {
+	for _, mode := range []Mode{ModePull, ModeWatch} {
+		t.Run(string(mode), func(t *testing.T) {
+			telemetryGVR := schema.GroupVersionResource{Group: telemetryResourceGroup, Version: telemetryResourceVersion, Resource: "telemetries"}
+			deploymentGVR := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+			dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					crdGVR:        "CustomResourceDefinitionList",
+					telemetryGVR:  "TelemetryList",
+					deploymentGVR: "DeploymentList",
+				},
+				newCRD("telemetries", telemetryResourceGroup, map[string]any{"kyma-project.io/module": "telemetry"},
+					map[string]any{"name": "v1alpha1", "served": true},
+					map[string]any{"name": telemetryResourceVersion, "served": true},
+				),
+				newCRD("certificates", "cert.gardener.cloud", nil,
+					map[string]any{"name": "v1alpha1", "served": true},
+				),
+				newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+			)
+
+			r, err := newKymaScraper(
+				Config{
+					MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+					Mode:                 mode,
+					Discovery: DiscoveryConfig{
+						Groups:   []string{"*.kyma-project.io"},
+						Interval: defaultDiscoveryInterval,
+					},
+				},
+				dynamic,
+				newTestRESTMapper(),
+				receivertest.NewNopSettings(metadata.Type),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, r.Shutdown(t.Context()))
+			}()
+
+			require.Eventually(t, func() bool {
+				md, err := r.ScrapeMetrics(t.Context())
+				if err != nil || md.ResourceMetrics().Len() != 1 {
+					return false
+				}
+
+				attrs := md.ResourceMetrics().At(0).Resource().Attributes()
+				group, _ := attrs.Get("k8s.resource.group")
+				version, _ := attrs.Get("k8s.resource.version")
+
+				return group.Str() == telemetryResourceGroup && version.Str() == telemetryResourceVersion
+			}, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestScrape_DiscoveryFails(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			crdGVR:             "CustomResourceDefinitionList",
+			resources[0].gvr(): "TelemetryList",
+		},
+		newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+	)
+
+	dynamic.PrependReactor("list", "customresourcedefinitions", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("error")
+	})
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+			Discovery: DiscoveryConfig{
+				LabelSelector: "kyma-project.io/module",
+				Interval:      defaultDiscoveryInterval,
+			},
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	// the configured resources are still collected
+	md, err := r.ScrapeMetrics(t.Context())
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+}
+
+func TestScrape_DiscoveryAndCollectionFail(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+			// an invalid path fails the collection of all resources, bypassing the config validation
+			Fields: []FieldConfig{{Path: "status.conditions[x]", Metric: "kyma.telemetry.invalid", Type: FieldTypeGauge}},
+		},
+	}
+
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			crdGVR:             "CustomResourceDefinitionList",
+			resources[0].gvr(): "TelemetryList",
+		},
+		newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+	)
+
+	client.PrependReactor("list", "customresourcedefinitions", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("discovery error")
+	})
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+			Discovery: DiscoveryConfig{
+				LabelSelector: "kyma-project.io/module",
+				Interval:      defaultDiscoveryInterval,
+			},
+		},
+		client,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	// both errors are reported
+	_, err = r.ScrapeMetrics(t.Context())
+	require.Error(t, err)
+	require.ErrorContains(t, err, "discovery error")
+	require.ErrorContains(t, err, "status.conditions[x]")
+}
+
+func newCRD(plural, group string, labels map[string]any, versions ...any) *unstructured.Unstructured {
+	obj := map[string]any{
+		"apiVersion": crdGVR.GroupVersion().String(),
+		"kind":       "CustomResourceDefinition",
+		"metadata": map[string]any{
+			"name": plural + "." + group,
+		},
+		"spec": map[string]any{
+			"group": group,
+			"names": map[string]any{
+				"plural": plural,
+			},
+			"versions": versions,
+		},
+	}
+
+	if labels != nil {
+		unstructured.SetNestedMap(obj, labels, "metadata", "labels")
+	}
+
+	return &unstructured.Unstructured{Object: obj}
+}
@@ -0,0 +1,104 @@
+package kymastatsreceiver
+
+import (
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
+)
+
+// Attributes of entity events, as defined by the OpenTelemetry entity data model for logs.
+const (
+	entityEventAsLogAttribute = "otel.entity.event_as_log"
+	entityEventTypeAttribute  = "otel.entity.event.type"
+	entityTypeAttribute       = "otel.entity.type"
+	entityIDAttribute         = "otel.entity.id"
+	entityAttributesAttribute = "otel.entity.attributes"
+	entityIntervalAttribute   = "otel.entity.interval"
+
+	entityEventTypeState  = "entity_state"
+	entityEventTypeDelete = "entity_delete"
+)
+
+// entityType returns the entity type of a resource kind, for example `k8s.telemetry` for the kind `Telemetry`.
+func entityType(kind string) string {
+	return "k8s." + strings.ToLower(kind)
+}
+
+// appendEntityState appends an entity state event describing the resource object. State events are emitted on every
+// scrape, the interval tells the backend when to expect the next event before considering the entity gone.
+func appendEntityState(records plog.LogRecordSlice, now pcommon.Timestamp, interval time.Duration, s *resourceStats) {
+	if s.uid == "" {
+		return
+	}
+
+	lr := newEntityEvent(records, now, entityEventTypeState, s.kind, s.uid)
+	lr.Attributes().PutInt(entityIntervalAttribute, interval.Milliseconds())
+
+	attrs := lr.Attributes().PutEmptyMap(entityAttributesAttribute)
+	attrs.PutStr("k8s.resource.name", s.name)
+
+	if s.namespace != "" {
+		attrs.PutStr("k8s.namespace.name", s.namespace)
+	}
+
+	attrs.PutStr("k8s.resource.group", s.group)
+	attrs.PutStr("k8s.resource.version", s.version)
+	attrs.PutStr("k8s.resource.kind", s.kind)
+
+	if s.hasState {
+		attrs.PutStr("k8s.resource.status.state", s.state)
+	}
+
+	for _, c := range s.conditions {
+		attrs.PutStr("k8s.resource.status.condition."+c.condType, c.status)
+	}
+
+	for k, v := range s.objectLabels {
+		attrs.PutStr("k8s.resource.label."+k, v)
+	}
+}
+
+// appendEntityDelete appends an entity delete event for a resource object that disappeared since the previous scrape.
+func appendEntityDelete(records plog.LogRecordSlice, now pcommon.Timestamp, key objectKey, snapshot objectSnapshot) {
+	if snapshot.uid == "" {
+		return
+	}
+
+	newEntityEvent(records, now, entityEventTypeDelete, key.kind, snapshot.uid)
+}
+
+func newEntityEvent(records plog.LogRecordSlice, now pcommon.Timestamp, eventType, kind, uid string) plog.LogRecord {
+	lr := records.AppendEmpty()
+	lr.SetTimestamp(now)
+	lr.SetObservedTimestamp(now)
+
+	entityType := entityType(kind)
+
+	attrs := lr.Attributes()
+	attrs.PutStr(entityEventTypeAttribute, eventType)
+	attrs.PutStr(entityTypeAttribute, entityType)
+	attrs.PutEmptyMap(entityIDAttribute).PutStr(entityType+".uid", uid)
+
+	return lr
+}
+
+// emitEntityEvents moves the entity events to ld. Entity events are kept apart from the other logs in their own scope,
+// which marks them as entity events, and their resource only carries the cluster identity.
+func (ks *kymaScraper) emitEntityEvents(ld plog.Logs, records plog.LogRecordSlice) {
+	if records.Len() == 0 {
+		return
+	}
+
+	rl := ld.ResourceLogs().AppendEmpty()
+	ks.clusterResource().MoveTo(rl.Resource())
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(metadata.ScopeName)
+	sl.Scope().SetVersion(ks.buildInfo.Version)
+	sl.Scope().Attributes().PutBool(entityEventAsLogAttribute, true)
+	records.MoveAndAppendTo(sl.LogRecords())
+}
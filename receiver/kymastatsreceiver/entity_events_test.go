@@ -0,0 +1,291 @@
+package kymastatsreceiver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
+)
+
+func TestScrapeLogs_EntityEvents(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	telemetry := newNamespacedTelemetry("default", telemetryResourceNamespace, map[string]any{"app.kubernetes.io/name": "telemetry"})
+	telemetry.SetUID(types.UID("telemetry-uid"))
+	unstructured.SetNestedSlice(telemetry.Object, []any{
+		map[string]any{"type": "LogComponentsHealthy", "status": "True", "reason": "AgentReady"},
+	}, "status", "conditions")
+
+	other := newNamespacedTelemetry("other", telemetryResourceNamespace, nil)
+	other.SetUID(types.UID("other-uid"))
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		telemetry, other,
+	)
+
+	r, err := newKymaLogsScraper(
+		Config{
+			ControllerConfig:     scraperhelper.ControllerConfig{CollectionInterval: 30 * time.Second},
+			MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+			Resources:            resources,
+			EntityEvents:         EntityEventsConfig{Enabled: true},
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	// every scrape emits a state event per object, while the first scrape emits no transitions
+	ld, err := r.ScrapeLogs(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+
+	scope := ld.ResourceLogs().At(0).ScopeLogs().At(0)
+	require.Equal(t, map[string]any{"otel.entity.event_as_log": true}, scope.Scope().Attributes().AsRaw())
+
+	events := entityEventsByName(scope.LogRecords())
+	require.Len(t, events, 2)
+
+	require.Equal(t, map[string]any{
+		"otel.entity.event.type": "entity_state",
+		"otel.entity.type":       "k8s.telemetry",
+		"otel.entity.id":         map[string]any{"k8s.telemetry.uid": "telemetry-uid"},
+		"otel.entity.interval":   int64(30000),
+		"otel.entity.attributes": map[string]any{
+			"k8s.resource.name":                                  "default",
+			"k8s.namespace.name":                                 telemetryResourceNamespace,
+			"k8s.resource.group":                                 telemetryResourceGroup,
+			"k8s.resource.version":                               telemetryResourceVersion,
+			"k8s.resource.kind":                                  "Telemetry",
+			"k8s.resource.status.state":                          "Ready",
+			"k8s.resource.status.condition.LogComponentsHealthy": "True",
+			"k8s.resource.label.app.kubernetes.io/name":          "telemetry",
+		},
+	}, events["default"].Attributes().AsRaw())
+
+	require.NoError(t, dynamic.Resource(resources[0].gvr()).Namespace(telemetryResourceNamespace).Delete(t.Context(), "other", metav1.DeleteOptions{}))
+
+	// a deleted object is emitted as a delete event
+	ld, err = r.ScrapeLogs(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+
+	records := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+
+	eventTypes := make(map[string]string)
+
+	for i := 0; i < records.Len(); i++ {
+		attrs := records.At(i).Attributes().AsRaw()
+		id := attrs["otel.entity.id"].(map[string]any)
+		eventTypes[id["k8s.telemetry.uid"].(string)] = attrs["otel.entity.event.type"].(string)
+	}
+
+	require.Equal(t, map[string]string{
+		"telemetry-uid": "entity_state",
+		"other-uid":     "entity_delete",
+	}, eventTypes)
+
+	// the object is only deleted once
+	ld, err = r.ScrapeLogs(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, ld.LogRecordCount())
+}
+
+func TestScrapeLogs_EntityEventsListFailure(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+		{
+			Group:    logPipelineResourceGroup,
+			Version:  logPipelineResourceVersion,
+			Resource: "logpipelines",
+		},
+	}
+
+	telemetry := newNamespacedTelemetry("default", telemetryResourceNamespace, nil)
+	telemetry.SetUID(types.UID("telemetry-uid"))
+
+	other := newNamespacedTelemetry("other", telemetryResourceNamespace, nil)
+	other.SetUID(types.UID("other-uid"))
+
+	pipeline := &unstructured.Unstructured{Object: newUnstructuredObject("LogPipeline", "logpipeline", "pipeline")}
+	pipeline.SetUID(types.UID("pipeline-uid"))
+	unstructured.SetNestedField(pipeline.Object, "Ready", "status", "state")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+			resources[1].gvr(): "LogPipelineList",
+		},
+		telemetry, other, pipeline,
+	)
+
+	failPipelines := false
+
+	dynamic.PrependReactor("list", "logpipelines", func(clienttesting.Action) (bool, runtime.Object, error) {
+		if failPipelines {
+			return true, nil, errors.New("error")
+		}
+
+		return false, nil, nil
+	})
+
+	r, err := newKymaLogsScraper(
+		Config{
+			MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+			Resources:            resources,
+			EntityEvents:         EntityEventsConfig{Enabled: true},
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	ld, err := r.ScrapeLogs(t.Context())
+	require.NoError(t, err)
+	require.Len(t, entityEventTypes(ld), 3)
+
+	// the log pipelines can't be listed, while an object of another resource is deleted
+	failPipelines = true
+
+	require.NoError(t, dynamic.Resource(resources[0].gvr()).Namespace(telemetryResourceNamespace).Delete(t.Context(), "other", metav1.DeleteOptions{}))
+
+	ld, err = r.ScrapeLogs(t.Context())
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.Equal(t, map[string]string{
+		"telemetry-uid": "entity_state",
+		"other-uid":     "entity_delete",
+	}, entityEventTypes(ld))
+
+	// the log pipeline is still known once it can be listed again
+	failPipelines = false
+
+	ld, err = r.ScrapeLogs(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"telemetry-uid": "entity_state",
+		"pipeline-uid":  "entity_state",
+	}, entityEventTypes(ld))
+}
+
+func TestScrapeLogs_EntityEventsDisabled(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	telemetry := newNamespacedTelemetry("default", telemetryResourceNamespace, nil)
+	telemetry.SetUID(types.UID("telemetry-uid"))
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		telemetry,
+	)
+
+	r, err := newKymaLogsScraper(
+		Config{
+			MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+			Resources:            resources,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	ld, err := r.ScrapeLogs(t.Context())
+	require.NoError(t, err)
+	require.Zero(t, ld.LogRecordCount())
+
+	require.NoError(t, dynamic.Resource(resources[0].gvr()).Namespace(telemetryResourceNamespace).Delete(t.Context(), "default", metav1.DeleteOptions{}))
+
+	ld, err = r.ScrapeLogs(t.Context())
+	require.NoError(t, err)
+	require.Zero(t, ld.LogRecordCount())
+}
+
+// entityEventTypes returns the entity event types by the UID of the described resource object.
+func entityEventTypes(ld plog.Logs) map[string]string {
+	res := make(map[string]string)
+
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		sls := ld.ResourceLogs().At(i).ScopeLogs()
+		for j := 0; j < sls.Len(); j++ {
+			records := sls.At(j).LogRecords()
+			for k := 0; k < records.Len(); k++ {
+				attrs := records.At(k).Attributes()
+
+				id, ok := attrs.Get(entityIDAttribute)
+				if !ok {
+					continue
+				}
+
+				eventType, _ := attrs.Get(entityEventTypeAttribute)
+
+				for _, uid := range id.Map().All() {
+					res[uid.Str()] = eventType.Str()
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// entityEventsByName returns the entity events by the name of the described resource object.
+func entityEventsByName(records plog.LogRecordSlice) map[string]plog.LogRecord {
+	res := make(map[string]plog.LogRecord)
+
+	for i := 0; i < records.Len(); i++ {
+		attrs, ok := records.At(i).Attributes().Get("otel.entity.attributes")
+		if !ok {
+			continue
+		}
+
+		name, _ := attrs.Map().Get("k8s.resource.name")
+		res[name.Str()] = records.At(i)
+	}
+
+	return res
+}
@@ -3,10 +3,12 @@ package kymastatsreceiver
 import (
 	"context"
 	"errors"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
 
 	"github.com/kyma-project/opentelemetry-collector-components/internal/k8sconfig"
@@ -15,13 +17,38 @@ import (
 
 var (
 	typeStr = component.MustNewType("kymastats")
+
+	// defaultStates are the states reported by Kyma modules and their managed resources
+	defaultStates = []string{"Ready", "Processing", "Error", "Deleting", "Warning"}
+)
+
+const (
+	defaultDiscoveryInterval     = 5 * time.Minute
+	defaultPageSize              = 500
+	defaultMaxConcurrentRequests = 5
+	defaultMessageMaxLength      = 128
 )
 
 func createDefaultConfig() component.Config {
 	return &Config{
-		AuthType:             k8sconfig.AuthTypeServiceAccount,
-		ControllerConfig:     scraperhelper.NewDefaultControllerConfig(),
-		MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+		AuthType:              k8sconfig.AuthTypeServiceAccount,
+		ControllerConfig:      scraperhelper.NewDefaultControllerConfig(),
+		MetricsBuilderConfig:  metadata.NewDefaultMetricsBuilderConfig(),
+		Mode:                  ModePull,
+		PageSize:              defaultPageSize,
+		MaxConcurrentRequests: defaultMaxConcurrentRequests,
+		StateSet: StateSetConfig{
+			States: defaultStates,
+		},
+		Conditions: ConditionsConfig{
+			MessageMaxLength: defaultMessageMaxLength,
+		},
+		Discovery: DiscoveryConfig{
+			Interval: defaultDiscoveryInterval,
+		},
+		Sharding: ShardingConfig{
+			Key: ShardingKeyUID,
+		},
 	}
 }
 
@@ -30,7 +57,8 @@ func NewFactory() receiver.Factory {
 	return receiver.NewFactory(
 		typeStr,
 		createDefaultConfig,
-		receiver.WithMetrics(createMetricsReceiver, component.StabilityLevelAlpha))
+		receiver.WithMetrics(createMetricsReceiver, component.StabilityLevelAlpha),
+		receiver.WithLogs(createLogsReceiver, component.StabilityLevelAlpha))
 }
 
 func createMetricsReceiver(_ context.Context, params receiver.Settings, baseCfg component.Config, consumer consumer.Metrics) (receiver.Metrics, error) {
@@ -39,19 +67,60 @@ func createMetricsReceiver(_ context.Context, params receiver.Settings, baseCfg
 		return nil, errors.New("invalid configuration")
 	}
 
-	dynamic, err := config.getDynamicClient()
+	trigger := newScrapeTrigger(config.CollectionInterval)
+
+	// the clients are created on start, so that a missing cluster is reported by the scrapes instead of failing the collector
+	scrp, err := newKymaScraper(
+		*config,
+		nil,
+		nil,
+		params,
+		withClients(config.getClients),
+		withScrapeTrigger(trigger),
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	scrp, err := newKymaScraper(
+	// The controller only scrapes on the ticks of its ticker, so replacing the ticker is the only way to scrape right
+	// away when the receiver becomes the leader. The option is meant for tests, but a receiver loop of our own would
+	// lose the telemetry and the partial error handling of the controller. TestFixtures_ScrapeTrigger fails if the
+	// controller stops scraping on the ticks of the channel.
+	return scraperhelper.NewMetricsController(&config.ControllerConfig, params, consumer,
+		scraperhelper.AddMetricsScraper(metadata.Type, scrp),
+		scraperhelper.WithTickerChannel(trigger.C()),
+	)
+}
+
+func createLogsReceiver(_ context.Context, params receiver.Settings, baseCfg component.Config, consumer consumer.Logs) (receiver.Logs, error) {
+	config, ok := baseCfg.(*Config)
+	if !ok {
+		return nil, errors.New("invalid configuration")
+	}
+
+	trigger := newScrapeTrigger(config.CollectionInterval)
+
+	// the clients are created on start, so that a missing cluster is reported by the scrapes instead of failing the collector
+	scrp, err := newKymaLogsScraper(
 		*config,
-		dynamic,
+		nil,
+		nil,
 		params,
+		withClients(config.getClients),
+		withScrapeTrigger(trigger),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return scraperhelper.NewMetricsController(&config.ControllerConfig, params, consumer, scraperhelper.AddMetricsScraper(metadata.Type, scrp))
+	f := scraper.NewFactory(metadata.Type, nil,
+		scraper.WithLogs(func(context.Context, scraper.Settings, component.Config) (scraper.Logs, error) {
+			return scrp, nil
+		}, component.StabilityLevelAlpha))
+
+	// the ticker is replaced for the same reason as in the metrics receiver
+	return scraperhelper.NewLogsController(&config.ControllerConfig, params, consumer,
+		scraperhelper.AddFactoryWithConfig(f, nil),
+		scraperhelper.WithTickerChannel(trigger.C()),
+	)
 }
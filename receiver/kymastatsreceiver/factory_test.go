@@ -1,6 +1,7 @@
 package kymastatsreceiver
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -11,8 +12,11 @@ import (
 	"go.opentelemetry.io/collector/pipeline"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
+	discoveryfake "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
 
 	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
 )
@@ -39,6 +43,9 @@ func TestCreateMetricsReceiver(t *testing.T) {
 				makeDynamicClient: func() (dynamic.Interface, error) {
 					return dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), nil
 				},
+				makeDiscoveryClient: func() (discovery.DiscoveryInterface, error) {
+					return &discoveryfake.FakeDiscovery{Fake: &clienttesting.Fake{}}, nil
+				},
 			},
 		},
 		{
@@ -86,31 +93,72 @@ func TestCreateTraceReceiver(t *testing.T) {
 }
 
 func TestCreateLogsReceiver(t *testing.T) {
-	factory := NewFactory()
-	logsReceiver, err := factory.CreateLogs(
-		t.Context(),
-		receivertest.NewNopSettings(metadata.Type),
-		&Config{
-			AuthType: "kubeConfig",
+	tests := []struct {
+		name        string
+		cfg         component.Config
+		expectedErr bool
+	}{
+		{
+			name: "valid",
+			cfg: &Config{
+				AuthType:             "kubeConfig",
+				CollectionInterval:   10 * time.Second,
+				InitialDelay:         time.Second,
+				MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+				makeDynamicClient: func() (dynamic.Interface, error) {
+					return dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), nil
+				},
+				makeDiscoveryClient: func() (discovery.DiscoveryInterface, error) {
+					return &discoveryfake.FakeDiscovery{Fake: &clienttesting.Fake{}}, nil
+				},
+			},
 		},
-		nil,
-	)
-	require.ErrorIs(t, err, pipeline.ErrSignalNotSupported)
-	require.Nil(t, logsReceiver)
+		{
+			name:        "invalid",
+			cfg:         component.Config([]byte{1, 2, 3}),
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := NewFactory()
+
+			logsReceiver, err := factory.CreateLogs(
+				t.Context(),
+				receivertest.NewNopSettings(metadata.Type),
+				tt.cfg,
+				consumertest.NewNop(),
+			)
+			if tt.expectedErr {
+				require.Error(t, err)
+				require.Nil(t, logsReceiver)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, logsReceiver)
+		})
+	}
 }
 
-func TestFactoryBadAuthType(t *testing.T) {
+// A missing cluster is reported by the scrapes, it neither fails the creation nor the start of the receiver.
+func TestFactoryNoCluster(t *testing.T) {
 	factory := NewFactory()
 	cfg := &Config{
-		AuthType: "none",
+		AuthType:           "none",
+		CollectionInterval: 10 * time.Second,
 	}
-	_, err := factory.CreateMetrics(
+	r, err := factory.CreateMetrics(
 		t.Context(),
 		receivertest.NewNopSettings(metadata.Type),
 		cfg,
 		consumertest.NewNop(),
 	)
-	require.Error(t, err)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	require.NoError(t, r.Shutdown(context.Background()))
 }
 
 func TestFactoryNoneAuthType(t *testing.T) {
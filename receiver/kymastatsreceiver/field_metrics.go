@@ -0,0 +1,254 @@
+package kymastatsreceiver
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// fieldExtractor extracts a configured field of a resource into samples of a custom metric.
+type fieldExtractor struct {
+	config     FieldConfig
+	path       fieldPath
+	attributes []fieldAttribute
+}
+
+type fieldAttribute struct {
+	name string
+	path fieldPath
+}
+
+// fieldSample is a single data point of a custom field metric.
+type fieldSample struct {
+	field      *FieldConfig
+	value      float64
+	attributes map[string]string
+}
+
+func newFieldExtractors(fields []FieldConfig) ([]fieldExtractor, error) {
+	res := make([]fieldExtractor, 0, len(fields))
+
+	for _, field := range fields {
+		path, err := parseFieldPath(field.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		fe := fieldExtractor{
+			config: field,
+			path:   path,
+		}
+
+		if fe.config.StateAttribute == "" {
+			fe.config.StateAttribute = defaultStateAttribute
+		}
+
+		for _, attr := range field.Attributes {
+			attrPath, err := parseFieldPath(attr.Path)
+			if err != nil {
+				return nil, err
+			}
+
+			fe.attributes = append(fe.attributes, fieldAttribute{name: attr.Name, path: attrPath})
+		}
+
+		res = append(res, fe)
+	}
+
+	return res, nil
+}
+
+// fieldExtractorCache holds the field extractors of the collected resources, so that the field paths are only parsed
+// when the fields of a resource change, for example by a reload of the resource ConfigMap. Entries are matched by the
+// whole resource config, as the same resource can be configured several times with different namespaces, selectors
+// or fields.
+type fieldExtractorCache struct {
+	mu      sync.Mutex
+	entries []fieldExtractorCacheEntry
+}
+
+type fieldExtractorCacheEntry struct {
+	resource   ResourceConfig
+	extractors []fieldExtractor
+}
+
+// get returns the field extractors of every resource, in the order of the resources. Extractors of resources that are
+// no longer collected are dropped.
+func (c *fieldExtractorCache) get(resources []ResourceConfig) ([][]fieldExtractor, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make([][]fieldExtractor, len(resources))
+	entries := make([]fieldExtractorCacheEntry, 0, len(resources))
+
+	for i, resource := range resources {
+		idx := slices.IndexFunc(c.entries, func(e fieldExtractorCacheEntry) bool {
+			return reflect.DeepEqual(e.resource, resource)
+		})
+
+		var entry fieldExtractorCacheEntry
+		if idx >= 0 {
+			entry = c.entries[idx]
+		} else {
+			extractors, err := newFieldExtractors(resource.Fields)
+			if err != nil {
+				return nil, err
+			}
+
+			entry = fieldExtractorCacheEntry{resource: resource, extractors: extractors}
+		}
+
+		entries = append(entries, entry)
+		res[i] = entry.extractors
+	}
+
+	c.entries = entries
+
+	return res, nil
+}
+
+// extract returns the samples of all values matched by the field path. Values that can't be converted are skipped
+// and reported in the returned error.
+func (fe *fieldExtractor) extract(obj map[string]any) ([]fieldSample, error) {
+	var (
+		res  []fieldSample
+		errs []error
+	)
+
+	for _, match := range fe.path.evaluate(obj) {
+		attrs := fe.extractAttributes(match.scope)
+
+		switch fe.config.Type {
+		case FieldTypeGauge:
+			val, err := fieldValueToFloat(match.value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("field %s: %w", fe.config.Path, err))
+				continue
+			}
+
+			res = append(res, fieldSample{field: &fe.config, value: val, attributes: attrs})
+		case FieldTypeStateSet:
+			state, ok := match.value.(string)
+			if !ok {
+				errs = append(errs, fmt.Errorf("field %s: state is not a string", fe.config.Path))
+				continue
+			}
+
+			res = append(res, fe.stateSetSamples(state, attrs)...)
+		}
+	}
+
+	return res, errors.Join(errs...)
+}
+
+func (fe *fieldExtractor) extractAttributes(scope map[string]any) map[string]string {
+	attrs := make(map[string]string, len(fe.attributes))
+
+	for _, attr := range fe.attributes {
+		for _, match := range attr.path.evaluate(scope) {
+			if val, ok := fieldValueToString(match.value); ok {
+				attrs[attr.name] = val
+				break
+			}
+		}
+	}
+
+	return attrs
+}
+
+func (fe *fieldExtractor) stateSetSamples(state string, attrs map[string]string) []fieldSample {
+	withState := func(s string) map[string]string {
+		res := make(map[string]string, len(attrs)+1)
+		for k, v := range attrs {
+			res[k] = v
+		}
+
+		res[fe.config.StateAttribute] = s
+
+		return res
+	}
+
+	res := []fieldSample{{field: &fe.config, value: 1, attributes: withState(state)}}
+
+	for _, known := range fe.config.States {
+		if known != state {
+			res = append(res, fieldSample{field: &fe.config, value: 0, attributes: withState(known)})
+		}
+	}
+
+	return res
+}
+
+func fieldValueToFloat(value any) (float64, error) {
+	switch val := value.(type) {
+	case int64:
+		return float64(val), nil
+	case float64:
+		return val, nil
+	case bool:
+		if val {
+			return 1, nil
+		}
+
+		return 0, nil
+	case string:
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return 0, fmt.Errorf("value %q is not numeric", val)
+		}
+
+		return f, nil
+	default:
+		return 0, fmt.Errorf("value of type %T is not numeric", value)
+	}
+}
+
+func fieldValueToString(value any) (string, bool) {
+	switch val := value.(type) {
+	case string:
+		return val, true
+	case int64, float64, bool:
+		return fmt.Sprint(val), true
+	default:
+		return "", false
+	}
+}
+
+// appendFieldMetrics appends the samples of a resource to the metric slice. Samples of the same field share a metric.
+func appendFieldMetrics(ms pmetric.MetricSlice, start, ts pcommon.Timestamp, s *resourceStats) {
+	metrics := make(map[string]pmetric.Metric)
+
+	for _, sample := range s.fields {
+		m, ok := metrics[sample.field.Metric]
+		if !ok {
+			m = ms.AppendEmpty()
+			m.SetName(sample.field.Metric)
+			m.SetDescription(sample.field.Description)
+			m.SetUnit(sample.field.Unit)
+			m.SetEmptyGauge()
+			metrics[sample.field.Metric] = m
+		}
+
+		dp := m.Gauge().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(start)
+		dp.SetTimestamp(ts)
+		dp.SetDoubleValue(sample.value)
+
+		attrs := dp.Attributes()
+		attrs.PutStr("group", s.group)
+		attrs.PutStr("kind", s.kind)
+		attrs.PutStr("name", s.name)
+		attrs.PutStr("namespace", s.namespace)
+		attrs.PutStr("version", s.version)
+
+		for k, v := range sample.attributes {
+			attrs.PutStr(k, v)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package kymastatsreceiver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// fieldPath is a parsed JSONPath-like expression, such as `status.readyReplicas` or `status.modules[*].state`.
+// Each segment selects a map key, optionally followed by a list index or a `[*]` wildcard that selects all list elements.
+type fieldPath []pathSegment
+
+type pathSegment struct {
+	key      string
+	index    int
+	hasIndex bool
+	wildcard bool
+}
+
+// fieldMatch is a single value selected by a fieldPath. The scope is the list element selected by the
+// innermost wildcard of the path, or the evaluated object itself if the path has no wildcard.
+type fieldMatch struct {
+	value any
+	scope map[string]any
+}
+
+func parseFieldPath(path string) (fieldPath, error) {
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid field path %q: path is empty", path)
+	}
+
+	var res fieldPath
+
+	for _, part := range strings.Split(trimmed, ".") {
+		seg, err := parsePathSegment(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field path %q: %w", path, err)
+		}
+
+		res = append(res, seg)
+	}
+
+	return res, nil
+}
+
+func parsePathSegment(part string) (pathSegment, error) {
+	key, selector, hasSelector := strings.Cut(part, "[")
+	if key == "" {
+		return pathSegment{}, fmt.Errorf("empty key in segment %q", part)
+	}
+
+	if !hasSelector {
+		return pathSegment{key: key}, nil
+	}
+
+	selector, ok := strings.CutSuffix(selector, "]")
+	if !ok {
+		return pathSegment{}, fmt.Errorf("unterminated list selector in segment %q", part)
+	}
+
+	if selector == "*" {
+		return pathSegment{key: key, wildcard: true}, nil
+	}
+
+	index, err := strconv.Atoi(selector)
+	if err != nil || index < 0 {
+		return pathSegment{}, fmt.Errorf("invalid list index in segment %q", part)
+	}
+
+	return pathSegment{key: key, index: index, hasIndex: true}, nil
+}
+
+// evaluate returns all values selected by the path. Missing fields or fields of an unexpected type don't match.
+func (p fieldPath) evaluate(obj map[string]any) []fieldMatch {
+	var res []fieldMatch
+
+	p.walk(obj, obj, &res)
+
+	return res
+}
+
+func (p fieldPath) walk(current any, scope map[string]any, res *[]fieldMatch) {
+	if len(p) == 0 {
+		*res = append(*res, fieldMatch{value: current, scope: scope})
+		return
+	}
+
+	m, ok := current.(map[string]any)
+	if !ok {
+		return
+	}
+
+	seg := p[0]
+
+	value, found := m[seg.key]
+	if !found {
+		return
+	}
+
+	if !seg.hasIndex && !seg.wildcard {
+		p[1:].walk(value, scope, res)
+		return
+	}
+
+	list, ok := value.([]any)
+	if !ok {
+		return
+	}
+
+	if seg.hasIndex {
+		if seg.index < len(list) {
+			p[1:].walk(list[seg.index], scope, res)
+		}
+
+		return
+	}
+
+	for _, elem := range list {
+		elemScope, ok := elem.(map[string]any)
+		if !ok {
+			elemScope = nil
+		}
+
+		p[1:].walk(elem, elemScope, res)
+	}
+}
@@ -0,0 +1,131 @@
+package kymastatsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFieldPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		expected  fieldPath
+		expectErr bool
+	}{
+		{
+			name:     "simple",
+			path:     "status.readyReplicas",
+			expected: fieldPath{{key: "status"}, {key: "readyReplicas"}},
+		},
+		{
+			name:     "jsonpath prefix",
+			path:     "$.status.state",
+			expected: fieldPath{{key: "status"}, {key: "state"}},
+		},
+		{
+			name:     "index",
+			path:     "status.conditions[1].status",
+			expected: fieldPath{{key: "status"}, {key: "conditions", index: 1, hasIndex: true}, {key: "status"}},
+		},
+		{
+			name:     "wildcard",
+			path:     "status.modules[*].state",
+			expected: fieldPath{{key: "status"}, {key: "modules", wildcard: true}, {key: "state"}},
+		},
+		{
+			name:      "empty",
+			path:      "$",
+			expectErr: true,
+		},
+		{
+			name:      "empty segment",
+			path:      "status..state",
+			expectErr: true,
+		},
+		{
+			name:      "unterminated selector",
+			path:      "status.modules[*",
+			expectErr: true,
+		},
+		{
+			name:      "invalid index",
+			path:      "status.modules[-1]",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := parseFieldPath(tt.path)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, path)
+		})
+	}
+}
+
+func TestFieldPathEvaluate(t *testing.T) {
+	obj := map[string]any{
+		"status": map[string]any{
+			"readyReplicas": int64(3),
+			"modules": []any{
+				map[string]any{"name": "telemetry", "state": "Ready"},
+				map[string]any{"name": "istio", "state": "Error"},
+				map[string]any{"name": "serverless"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedValues []any
+	}{
+		{
+			name:           "scalar",
+			path:           "status.readyReplicas",
+			expectedValues: []any{int64(3)},
+		},
+		{
+			name:           "index",
+			path:           "status.modules[1].name",
+			expectedValues: []any{"istio"},
+		},
+		{
+			name: "index out of range",
+			path: "status.modules[5].name",
+		},
+		{
+			name:           "wildcard skips missing fields",
+			path:           "status.modules[*].state",
+			expectedValues: []any{"Ready", "Error"},
+		},
+		{
+			name: "missing field",
+			path: "status.unknown",
+		},
+		{
+			name: "wildcard on non-list",
+			path: "status.readyReplicas[*]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := parseFieldPath(tt.path)
+			require.NoError(t, err)
+
+			var values []any
+			for _, match := range path.evaluate(obj) {
+				values = append(values, match.value)
+			}
+
+			require.Equal(t, tt.expectedValues, values)
+		})
+	}
+}
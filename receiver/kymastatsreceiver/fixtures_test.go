@@ -0,0 +1,418 @@
+package kymastatsreceiver
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/fakecluster"
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/k8sleaderelectortest"
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
+)
+
+// The tests in this file run the receiver against the objects of testdata/fixtures, which are served by in-memory clients.
+
+var (
+	fixtureTelemetries = ResourceConfig{
+		Group:    "operator.kyma-project.io",
+		Version:  "v1alpha1",
+		Resource: "telemetries",
+	}
+	fixtureLogPipelines = ResourceConfig{
+		Group:    "telemetry.kyma-project.io",
+		Version:  "v1alpha1",
+		Resource: "logpipelines",
+	}
+)
+
+func TestFixtures_Metrics(t *testing.T) {
+	cfg := newFixtureConfig(fixtureTelemetries, fixtureLogPipelines)
+	cfg.ResourceAttributes.K8sClusterUID.Enabled = true
+
+	sink := startFixtureMetricsReceiver(t, cfg, componenttest.NewNopHost())
+
+	md := waitForScrape(t, sink)
+	require.Equal(t, []string{"application-logs", "default", "staging", "testing"}, scrapedObjects(md))
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		uid, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.cluster.uid")
+		require.True(t, ok)
+		require.Equal(t, "0f5d2a4e-7a4c-4d1b-9a36-3c8d1e6f2b71", uid.Str())
+	}
+}
+
+func TestFixtures_Pagination(t *testing.T) {
+	cfg := newFixtureConfig(fixtureTelemetries)
+	cfg.PageSize = 1
+
+	client := &listRecordingClient{}
+	makeDynamicClient := cfg.makeDynamicClient
+	cfg.makeDynamicClient = func() (dynamic.Interface, error) {
+		var err error
+		client.Interface, err = makeDynamicClient()
+
+		return client, err
+	}
+
+	sink := startFixtureMetricsReceiver(t, cfg, componenttest.NewNopHost())
+
+	require.Equal(t, []string{"default", "staging", "testing"}, scrapedObjects(waitForScrape(t, sink)))
+
+	// the first scrape lists the telemetries page by page
+	requests := client.requests(fixtureTelemetries.gvr())
+	require.GreaterOrEqual(t, len(requests), 3)
+	require.Equal(t, []listRequest{
+		{limit: 1},
+		{limit: 1, continueToken: "1"},
+		{limit: 1, continueToken: "2"},
+	}, requests[:3])
+}
+
+func TestFixtures_Discovery(t *testing.T) {
+	cfg := newFixtureConfig()
+	cfg.Discovery.Groups = []string{"*.kyma-project.io"}
+
+	sink := startFixtureMetricsReceiver(t, cfg, componenttest.NewNopHost())
+
+	require.Equal(t, []string{"application-logs", "default", "staging", "testing"}, scrapedObjects(waitForScrape(t, sink)))
+}
+
+func TestFixtures_LeaderElection(t *testing.T) {
+	fakeLeaderElection := &k8sleaderelectortest.FakeLeaderElection{}
+	leaderElectorID := component.MustNewID("k8s_leader_elector")
+
+	cfg := newFixtureConfig(fixtureTelemetries)
+	cfg.K8sLeaderElector = &leaderElectorID
+
+	dynamic, err := cfg.getDynamicClient()
+	require.NoError(t, err)
+
+	mapper, err := cfg.getRESTMapper()
+	require.NoError(t, err)
+
+	trigger := newScrapeTrigger(time.Hour)
+
+	r, err := newKymaScraper(*cfg, dynamic, mapper, receivertest.NewNopSettings(metadata.Type), withScrapeTrigger(trigger))
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), &k8sleaderelectortest.FakeHost{FakeLeaderElection: fakeLeaderElection}))
+	defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+	// before being a leader
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Zero(t, md.DataPointCount())
+
+	// elected leader, which requests a scrape right away
+	fakeLeaderElection.InvokeOnLeading()
+
+	select {
+	case <-trigger.C():
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "no scrape requested after gaining leadership")
+	}
+
+	md, err = r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, []string{"default", "staging", "testing"}, scrapedObjects(md))
+
+	// stopped leading
+	fakeLeaderElection.InvokeOnStopping()
+
+	md, err = r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Zero(t, md.DataPointCount())
+}
+
+// The receivers replace the ticker of the scraper controller with the scrape trigger. Gaining the leadership must scrape
+// right away, even though the collection interval is long, which fails if the controller stops scraping on the ticks.
+func TestFixtures_ScrapeTrigger(t *testing.T) {
+	leaderElectorID := component.MustNewID("k8s_leader_elector")
+
+	cfg := newFixtureConfig(fixtureTelemetries)
+	cfg.CollectionInterval = time.Hour
+	cfg.K8sLeaderElector = &leaderElectorID
+	cfg.EntityEvents.Enabled = true
+
+	t.Run("metrics", func(t *testing.T) {
+		fakeLeaderElection := &k8sleaderelectortest.FakeLeaderElection{}
+
+		sink := startFixtureMetricsReceiver(t, cfg, &k8sleaderelectortest.FakeHost{FakeLeaderElection: fakeLeaderElection})
+
+		// the controller scrapes once on start, which is done before leading
+		require.Eventually(t, func() bool {
+			return len(sink.AllMetrics()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		fakeLeaderElection.InvokeOnLeading()
+
+		require.Equal(t, []string{"default", "staging", "testing"}, scrapedObjects(waitForScrape(t, sink)))
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		fakeLeaderElection := &k8sleaderelectortest.FakeLeaderElection{}
+
+		sink := new(consumertest.LogsSink)
+
+		r, err := NewFactory().CreateLogs(t.Context(), receivertest.NewNopSettings(metadata.Type), cfg, sink)
+		require.NoError(t, err)
+		require.NoError(t, r.Start(t.Context(), &k8sleaderelectortest.FakeHost{FakeLeaderElection: fakeLeaderElection}))
+		t.Cleanup(func() { require.NoError(t, r.Shutdown(context.Background())) })
+
+		require.Eventually(t, func() bool {
+			return len(sink.AllLogs()) == 1
+		}, 5*time.Second, 10*time.Millisecond)
+
+		fakeLeaderElection.InvokeOnLeading()
+
+		require.Eventually(t, func() bool {
+			return sink.LogRecordCount() > 0
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestFixtures_Failures(t *testing.T) {
+	functions := ResourceConfig{
+		Group:    "serverless.kyma-project.io",
+		Version:  "v1alpha2",
+		Resource: "functions",
+	}
+
+	t.Run("resource not served", func(t *testing.T) {
+		sink := startFixtureMetricsReceiver(t, newFixtureConfig(fixtureTelemetries, functions), componenttest.NewNopHost())
+
+		// the served resource is still collected, while the failure is reported per resource
+		md := waitForScrape(t, sink)
+		require.Equal(t, []string{"default", "staging", "testing"}, scrapedObjects(md))
+		require.Equal(t, map[string]int64{"functions": 1, "telemetries": 0}, scrapeErrors(md))
+	})
+
+	t.Run("cluster name ConfigMap missing", func(t *testing.T) {
+		cfg := newFixtureConfig(fixtureTelemetries)
+		cfg.ResourceAttributes.K8sClusterName.Enabled = true
+		cfg.ClusterName.ConfigMap = &ConfigMapKeyConfig{Namespace: "kyma-system", Name: "cluster-info", Key: "name"}
+
+		sink := startFixtureMetricsReceiver(t, cfg, componenttest.NewNopHost())
+
+		// the resources are collected without the cluster name
+		md := waitForScrape(t, sink)
+		require.Equal(t, []string{"default", "staging", "testing"}, scrapedObjects(md))
+
+		_, ok := md.ResourceMetrics().At(0).Resource().Attributes().Get("k8s.cluster.name")
+		require.False(t, ok)
+	})
+}
+
+func TestFixtures_EntityEvents(t *testing.T) {
+	cfg := newFixtureConfig(fixtureTelemetries)
+	cfg.EntityEvents.Enabled = true
+
+	sink := new(consumertest.LogsSink)
+
+	r, err := NewFactory().CreateLogs(t.Context(), receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	t.Cleanup(func() { require.NoError(t, r.Shutdown(context.Background())) })
+
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	ld := sink.AllLogs()[0]
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+
+	events := entityEventsByName(ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords())
+	require.Len(t, events, 3)
+	require.Contains(t, events, "default")
+	require.Contains(t, events, "staging")
+	require.Contains(t, events, "testing")
+}
+
+// newFixtureConfig returns the default config reading the fixture objects, which scrapes without delay.
+func newFixtureConfig(resources ...ResourceConfig) *Config {
+	cfg := createDefaultConfig().(*Config)
+	cfg.CollectionInterval = 10 * time.Millisecond
+	cfg.InitialDelay = 0
+	cfg.Resources = resources
+	withFixtures(cfg)
+
+	return cfg
+}
+
+// withFixtures replaces the clients of the config with in-memory clients serving the objects of testdata/fixtures.
+// Every created client holds its own copy of the objects.
+func withFixtures(cfg *Config) {
+	patterns := []string{"testdata/fixtures/*.yaml"}
+
+	cfg.makeDynamicClient = func() (dynamic.Interface, error) {
+		cluster, err := fakecluster.Load(patterns)
+		if err != nil {
+			return nil, err
+		}
+
+		return cluster.DynamicClient()
+	}
+
+	cfg.makeDiscoveryClient = func() (discovery.DiscoveryInterface, error) {
+		cluster, err := fakecluster.Load(patterns)
+		if err != nil {
+			return nil, err
+		}
+
+		return cluster.DiscoveryClient(), nil
+	}
+}
+
+func startFixtureMetricsReceiver(t *testing.T, cfg *Config, host component.Host) *consumertest.MetricsSink {
+	t.Helper()
+
+	require.NoError(t, cfg.Validate())
+
+	sink := new(consumertest.MetricsSink)
+
+	r, err := NewFactory().CreateMetrics(t.Context(), receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	require.NoError(t, r.Start(t.Context(), host))
+	t.Cleanup(func() { require.NoError(t, r.Shutdown(context.Background())) })
+
+	return sink
+}
+
+// waitForScrape returns the metrics of the first scrape that emitted data points.
+func waitForScrape(t *testing.T, sink *consumertest.MetricsSink) pmetric.Metrics {
+	t.Helper()
+
+	var res pmetric.Metrics
+
+	require.Eventually(t, func() bool {
+		for _, md := range sink.AllMetrics() {
+			if md.DataPointCount() > 0 {
+				res = md
+				return true
+			}
+		}
+
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return res
+}
+
+// scrapedObjects returns the sorted names of the resource objects with metrics.
+func scrapedObjects(md pmetric.Metrics) []string {
+	var names []string
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		if name, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.resource.name"); ok {
+			names = append(names, name.Str())
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// scrapeErrors returns the values of the `kyma.resource.scrape.errors` metric by resource.
+func scrapeErrors(md pmetric.Metrics) map[string]int64 {
+	res := make(map[string]int64)
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				if ms.At(k).Name() != "kyma.resource.scrape.errors" {
+					continue
+				}
+
+				dps := ms.At(k).Gauge().DataPoints()
+				for l := 0; l < dps.Len(); l++ {
+					resource, _ := dps.At(l).Attributes().Get("resource")
+					res[resource.Str()] = dps.At(l).IntValue()
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+type listRequest struct {
+	limit         int64
+	continueToken string
+}
+
+// listRecordingClient records the pagination options of every list request.
+type listRecordingClient struct {
+	dynamic.Interface
+
+	mu    sync.Mutex
+	lists map[schema.GroupVersionResource][]listRequest
+}
+
+func (c *listRecordingClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &listRecordingResource{NamespaceableResourceInterface: c.Interface.Resource(gvr), client: c, gvr: gvr}
+}
+
+func (c *listRecordingClient) record(gvr schema.GroupVersionResource, opts metav1.ListOptions) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lists == nil {
+		c.lists = make(map[schema.GroupVersionResource][]listRequest)
+	}
+
+	c.lists[gvr] = append(c.lists[gvr], listRequest{limit: opts.Limit, continueToken: opts.Continue})
+}
+
+func (c *listRecordingClient) requests(gvr schema.GroupVersionResource) []listRequest {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return slices.Clone(c.lists[gvr])
+}
+
+type listRecordingResource struct {
+	dynamic.NamespaceableResourceInterface
+
+	client *listRecordingClient
+	gvr    schema.GroupVersionResource
+}
+
+func (r *listRecordingResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &listRecordingNamespacedResource{ResourceInterface: r.NamespaceableResourceInterface.Namespace(namespace), client: r.client, gvr: r.gvr}
+}
+
+func (r *listRecordingResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	r.client.record(r.gvr, opts)
+
+	return r.NamespaceableResourceInterface.List(ctx, opts)
+}
+
+type listRecordingNamespacedResource struct {
+	dynamic.ResourceInterface
+
+	client *listRecordingClient
+	gvr    schema.GroupVersionResource
+}
+
+func (r *listRecordingNamespacedResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	r.client.record(r.gvr, opts)
+
+	return r.ResourceInterface.List(ctx, opts)
+}
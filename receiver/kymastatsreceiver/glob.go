@@ -0,0 +1,58 @@
+package kymastatsreceiver
+
+import (
+	"regexp"
+	"strings"
+)
+
+// globMatcher matches keys against a list of glob patterns, in which `*` matches any sequence of characters,
+// including `/`. For example, `app.kubernetes.io/*` matches all keys with the `app.kubernetes.io/` prefix.
+type globMatcher []*regexp.Regexp
+
+func newGlobMatcher(patterns []string) globMatcher {
+	res := make(globMatcher, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		parts := strings.Split(pattern, "*")
+		for i := range parts {
+			parts[i] = regexp.QuoteMeta(parts[i])
+		}
+
+		res = append(res, regexp.MustCompile("^"+strings.Join(parts, ".*")+"$"))
+	}
+
+	return res
+}
+
+func (gm globMatcher) matches(key string) bool {
+	for _, re := range gm {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// filter returns the entries of m with matching keys, or nil if no key matches.
+func (gm globMatcher) filter(m map[string]string) map[string]string {
+	if len(gm) == 0 {
+		return nil
+	}
+
+	var res map[string]string
+
+	for k, v := range m {
+		if !gm.matches(k) {
+			continue
+		}
+
+		if res == nil {
+			res = make(map[string]string)
+		}
+
+		res[k] = v
+	}
+
+	return res
+}
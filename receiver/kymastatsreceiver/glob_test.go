@@ -0,0 +1,40 @@
+package kymastatsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobMatcher(t *testing.T) {
+	gm := newGlobMatcher([]string{"app.kubernetes.io/*", "operator.kyma-project.io/managed-by", "*team*"})
+
+	tests := []struct {
+		key      string
+		expected bool
+	}{
+		{key: "app.kubernetes.io/name", expected: true},
+		{key: "app.kubernetes.io/part-of", expected: true},
+		{key: "app.kubernetes.io", expected: false},
+		{key: "appXkubernetes.io/name", expected: false},
+		{key: "operator.kyma-project.io/managed-by", expected: true},
+		{key: "operator.kyma-project.io/managed-by-someone", expected: false},
+		{key: "example.com/owning-team", expected: true},
+		{key: "kyma-project.io/module", expected: false},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, gm.matches(tt.key), tt.key)
+	}
+}
+
+func TestGlobMatcherFilter(t *testing.T) {
+	m := map[string]string{
+		"app.kubernetes.io/name": "telemetry",
+		"kyma-project.io/module": "telemetry",
+	}
+
+	require.Nil(t, newGlobMatcher(nil).filter(m))
+	require.Nil(t, newGlobMatcher([]string{"example.com/*"}).filter(m))
+	require.Equal(t, map[string]string{"app.kubernetes.io/name": "telemetry"}, newGlobMatcher([]string{"app.kubernetes.io/*"}).filter(m))
+}
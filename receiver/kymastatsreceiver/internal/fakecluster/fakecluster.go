@@ -0,0 +1,387 @@
+// Package fakecluster serves Kubernetes objects loaded from YAML files through in-memory clients, so that the receiver
+// can be tested end to end without a cluster.
+package fakecluster
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+var crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// builtinResources are served even without any fixture objects, as they are read by the receiver itself.
+var builtinResources = map[schema.GroupVersionResource]resource{
+	{Version: "v1", Resource: "namespaces"}: {kind: "Namespace"},
+	{Version: "v1", Resource: "configmaps"}: {kind: "ConfigMap", namespaced: true},
+	crdGVR:                                  {kind: "CustomResourceDefinition"},
+}
+
+// supportedFieldSelectors are the fields, which can be selected for every resource by a real API server.
+var supportedFieldSelectors = []string{"metadata.name", "metadata.namespace"}
+
+type resource struct {
+	kind       string
+	namespaced bool
+}
+
+// Cluster holds the objects of the fixture files. The served resources are the built-in resources, the resources
+// defined by CustomResourceDefinition fixtures, and the resources of all other fixture objects.
+type Cluster struct {
+	objects   []*unstructured.Unstructured
+	resources map[schema.GroupVersionResource]resource
+}
+
+// Load loads the objects of the YAML files matching the glob patterns. A file can hold multiple documents,
+// and lists like the output of `kubectl get -o yaml` are expanded into their items.
+func Load(patterns []string) (*Cluster, error) {
+	var objects []*unstructured.Unstructured
+
+	for _, pattern := range patterns {
+		paths, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fixture pattern %q: %w", pattern, err)
+		}
+
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("no fixture files match %q", pattern)
+		}
+
+		for _, path := range paths {
+			objs, err := loadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load fixture file %s: %w", path, err)
+			}
+
+			objects = append(objects, objs...)
+		}
+	}
+
+	c := &Cluster{
+		objects:   objects,
+		resources: maps.Clone(builtinResources),
+	}
+
+	// CustomResourceDefinitions are registered first, so that their resource names take precedence over guessed ones
+	for _, obj := range objects {
+		if obj.GroupVersionKind().GroupKind() == (schema.GroupKind{Group: crdGVR.Group, Kind: "CustomResourceDefinition"}) {
+			c.addCRD(obj)
+		}
+	}
+
+	for _, obj := range objects {
+		gvr := c.gvr(obj.GroupVersionKind())
+
+		r := c.resources[gvr]
+		r.kind = obj.GetKind()
+		r.namespaced = r.namespaced || obj.GetNamespace() != ""
+		c.resources[gvr] = r
+	}
+
+	return c, nil
+}
+
+func loadFile(path string) ([]*unstructured.Unstructured, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return decode(f)
+}
+
+func decode(r io.Reader) ([]*unstructured.Unstructured, error) {
+	reader := yaml.NewYAMLReader(bufio.NewReader(r))
+
+	var res []*unstructured.Unstructured
+
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return res, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := yaml.ToJSON(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		// documents without content, for example holding only comments
+		if len(bytes.TrimSpace(data)) == 0 || bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+			continue
+		}
+
+		obj, _, err := unstructured.UnstructuredJSONScheme.Decode(data, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		switch obj := obj.(type) {
+		case *unstructured.Unstructured:
+			if obj.GetName() == "" {
+				return nil, fmt.Errorf("%s without name", obj.GetKind())
+			}
+
+			res = append(res, obj)
+		case *unstructured.UnstructuredList:
+			for i := range obj.Items {
+				res = append(res, &obj.Items[i])
+			}
+		}
+	}
+}
+
+// addCRD registers the served versions of the resource defined by a CustomResourceDefinition.
+func (c *Cluster) addCRD(crd *unstructured.Unstructured) {
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	plural, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	scope, _, _ := unstructured.NestedString(crd.Object, "spec", "scope")
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+
+	for _, v := range versions {
+		version, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name, _, _ := unstructured.NestedString(version, "name")
+		served, _, _ := unstructured.NestedBool(version, "served")
+
+		if name == "" || !served {
+			continue
+		}
+
+		c.resources[schema.GroupVersionResource{Group: group, Version: name, Resource: plural}] = resource{
+			kind:       kind,
+			namespaced: scope == "Namespaced",
+		}
+	}
+}
+
+// gvr returns the resource of a kind, which is guessed if the kind is not served yet.
+func (c *Cluster) gvr(gvk schema.GroupVersionKind) schema.GroupVersionResource {
+	for gvr, r := range c.resources {
+		if gvr.GroupVersion() == gvk.GroupVersion() && r.kind == gvk.Kind {
+			return gvr
+		}
+	}
+
+	gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+
+	return gvr
+}
+
+// DynamicClient returns a new dynamic client holding a copy of the fixture objects. Unlike the fake client of
+// client-go, requests for resources that aren't served fail with NotFound, list requests are paginated, and
+// list requests support the field selectors that a real API server supports for every resource.
+func (c *Cluster) DynamicClient() (dynamic.Interface, error) {
+	listKinds := make(map[schema.GroupVersionResource]string, len(c.resources))
+	for gvr, r := range c.resources {
+		listKinds[gvr] = r.kind + "List"
+	}
+
+	fake := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds)
+
+	for _, obj := range c.objects {
+		if err := fake.Tracker().Create(c.gvr(obj.GroupVersionKind()), obj.DeepCopy(), obj.GetNamespace()); err != nil {
+			return nil, fmt.Errorf("failed to add %s %s/%s: %w", obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+		}
+	}
+
+	return &dynamicClient{
+		fake:      fake,
+		resources: c.resources,
+	}, nil
+}
+
+// DiscoveryClient returns a discovery client serving the resources of the cluster.
+func (c *Cluster) DiscoveryClient() discovery.DiscoveryInterface {
+	lists := make(map[string]*metav1.APIResourceList)
+
+	for _, gvr := range slices.SortedFunc(maps.Keys(c.resources), compareGVR) {
+		gv := gvr.GroupVersion().String()
+		if lists[gv] == nil {
+			lists[gv] = &metav1.APIResourceList{GroupVersion: gv}
+		}
+
+		r := c.resources[gvr]
+		lists[gv].APIResources = append(lists[gv].APIResources, metav1.APIResource{
+			Name:       gvr.Resource,
+			Kind:       r.kind,
+			Namespaced: r.namespaced,
+			Verbs:      metav1.Verbs{"get", "list", "watch"},
+		})
+	}
+
+	var resources []*metav1.APIResourceList
+	for _, gv := range slices.Sorted(maps.Keys(lists)) {
+		resources = append(resources, lists[gv])
+	}
+
+	return &discoveryfake.FakeDiscovery{Fake: &clienttesting.Fake{Resources: resources}}
+}
+
+func compareGVR(a, b schema.GroupVersionResource) int {
+	return cmp.Or(
+		cmp.Compare(a.Group, b.Group),
+		cmp.Compare(a.Version, b.Version),
+		cmp.Compare(a.Resource, b.Resource),
+	)
+}
+
+type dynamicClient struct {
+	fake      *dynamicfake.FakeDynamicClient
+	resources map[schema.GroupVersionResource]resource
+}
+
+func (c *dynamicClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	client := c.fake.Resource(gvr)
+	_, served := c.resources[gvr]
+
+	return &resourceClient{
+		ResourceInterface: client,
+		namespaceable:     client,
+		gvr:               gvr,
+		served:            served,
+	}
+}
+
+// IsWatchListSemanticsUnSupported tells informers to list and watch, as the fake client can't stream the initial list.
+func (c *dynamicClient) IsWatchListSemanticsUnSupported() bool {
+	return c.fake.IsWatchListSemanticsUnSupported()
+}
+
+// resourceClient overrides the read requests of the fake client, which are the only requests sent by the receiver.
+type resourceClient struct {
+	dynamic.ResourceInterface
+
+	namespaceable dynamic.NamespaceableResourceInterface
+	gvr           schema.GroupVersionResource
+	served        bool
+}
+
+func (c *resourceClient) Namespace(namespace string) dynamic.ResourceInterface {
+	return &resourceClient{
+		ResourceInterface: c.namespaceable.Namespace(namespace),
+		namespaceable:     c.namespaceable,
+		gvr:               c.gvr,
+		served:            c.served,
+	}
+}
+
+func (c *resourceClient) Get(ctx context.Context, name string, opts metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	if !c.served {
+		return nil, c.notServed()
+	}
+
+	return c.ResourceInterface.Get(ctx, name, opts, subresources...)
+}
+
+func (c *resourceClient) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	if !c.served {
+		return nil, c.notServed()
+	}
+
+	selector, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	for _, req := range selector.Requirements() {
+		if !slices.Contains(supportedFieldSelectors, req.Field) {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("field label not supported: %s", req.Field))
+		}
+	}
+
+	list, err := c.ResourceInterface.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	list.Items = slices.DeleteFunc(list.Items, func(obj unstructured.Unstructured) bool {
+		return !selector.Matches(fields.Set{
+			"metadata.name":      obj.GetName(),
+			"metadata.namespace": obj.GetNamespace(),
+		})
+	})
+
+	return paginate(list, opts)
+}
+
+func (c *resourceClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	if !c.served {
+		return nil, c.notServed()
+	}
+
+	return c.ResourceInterface.Watch(ctx, opts)
+}
+
+func (c *resourceClient) notServed() error {
+	return apierrors.NewNotFound(c.gvr.GroupResource(), "")
+}
+
+// paginate returns the page of the list selected by the limit and continue token of the list options. Like the API
+// server, the objects are ordered by namespace and name, while the continue token is simply the offset of the page.
+func paginate(list *unstructured.UnstructuredList, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	slices.SortFunc(list.Items, func(a, b unstructured.Unstructured) int {
+		return cmp.Or(
+			cmp.Compare(a.GetNamespace(), b.GetNamespace()),
+			cmp.Compare(a.GetName(), b.GetName()),
+		)
+	})
+
+	if opts.Limit <= 0 {
+		return list, nil
+	}
+
+	start := 0
+
+	if opts.Continue != "" {
+		var err error
+
+		start, err = strconv.Atoi(opts.Continue)
+		if err != nil || start < 0 || start > len(list.Items) {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid continue token %q", opts.Continue))
+		}
+	}
+
+	end := min(start+int(opts.Limit), len(list.Items))
+
+	if end < len(list.Items) {
+		list.SetContinue(strconv.Itoa(end))
+	}
+
+	list.Items = list.Items[start:end]
+
+	return list, nil
+}
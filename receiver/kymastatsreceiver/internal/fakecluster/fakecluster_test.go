@@ -0,0 +1,208 @@
+package fakecluster
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const fixture = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: telemetries.operator.kyma-project.io
+spec:
+  group: operator.kyma-project.io
+  names:
+    kind: Telemetry
+    plural: telemetries
+  scope: Namespaced
+  versions:
+    - name: v1alpha1
+      served: true
+    - name: v1beta1
+      served: false
+---
+# recorded with kubectl get telemetries -A -o yaml
+apiVersion: v1
+kind: List
+items:
+  - apiVersion: operator.kyma-project.io/v1alpha1
+    kind: Telemetry
+    metadata:
+      name: c
+      namespace: kyma-system
+    status:
+      state: Ready
+  - apiVersion: operator.kyma-project.io/v1alpha1
+    kind: Telemetry
+    metadata:
+      name: a
+      namespace: kyma-system
+  - apiVersion: operator.kyma-project.io/v1alpha1
+    kind: Telemetry
+    metadata:
+      name: b
+      namespace: default
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: kube-system
+  uid: cluster-uid
+`
+
+var telemetriesGVR = schema.GroupVersionResource{Group: "operator.kyma-project.io", Version: "v1alpha1", Resource: "telemetries"}
+
+func TestLoad(t *testing.T) {
+	c := loadFixture(t, fixture)
+
+	require.Len(t, c.objects, 5)
+	require.Equal(t, resource{kind: "Telemetry", namespaced: true}, c.resources[telemetriesGVR])
+	require.NotContains(t, c.resources, schema.GroupVersionResource{Group: "operator.kyma-project.io", Version: "v1beta1", Resource: "telemetries"})
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		data    string
+	}{
+		{
+			name:    "no matching file",
+			pattern: "missing-*.yaml",
+		},
+		{
+			name:    "invalid pattern",
+			pattern: "[",
+		},
+		{
+			name:    "invalid yaml",
+			pattern: "*.yaml",
+			data:    "kind: [",
+		},
+		{
+			name:    "missing kind",
+			pattern: "*.yaml",
+			data:    "apiVersion: v1\nmetadata:\n  name: test\n",
+		},
+		{
+			name:    "missing name",
+			pattern: "*.yaml",
+			data:    "apiVersion: v1\nkind: ConfigMap\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if tt.data != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "fixture.yaml"), []byte(tt.data), 0o600))
+			}
+
+			_, err := Load([]string{filepath.Join(dir, tt.pattern)})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDynamicClient(t *testing.T) {
+	client, err := loadFixture(t, fixture).DynamicClient()
+	require.NoError(t, err)
+
+	ns, err := client.Resource(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}).Get(t.Context(), "kube-system", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "cluster-uid", string(ns.GetUID()))
+
+	t.Run("pagination", func(t *testing.T) {
+		var names []string
+
+		opts := metav1.ListOptions{Limit: 2}
+
+		for {
+			list, err := client.Resource(telemetriesGVR).List(t.Context(), opts)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(list.Items), 2)
+
+			names = append(names, objectNames(list)...)
+
+			if list.GetContinue() == "" {
+				break
+			}
+
+			opts.Continue = list.GetContinue()
+		}
+
+		require.Equal(t, []string{"b", "a", "c"}, names)
+	})
+
+	t.Run("namespace", func(t *testing.T) {
+		list, err := client.Resource(telemetriesGVR).Namespace("kyma-system").List(t.Context(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "c"}, objectNames(list))
+	})
+
+	t.Run("field selector", func(t *testing.T) {
+		list, err := client.Resource(telemetriesGVR).List(t.Context(), metav1.ListOptions{FieldSelector: "metadata.name=c"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"c"}, objectNames(list))
+
+		_, err = client.Resource(telemetriesGVR).List(t.Context(), metav1.ListOptions{FieldSelector: "status.state=Ready"})
+		require.True(t, apierrors.IsBadRequest(err))
+	})
+
+	t.Run("not served", func(t *testing.T) {
+		gvr := schema.GroupVersionResource{Group: "operator.kyma-project.io", Version: "v1alpha1", Resource: "logpipelines"}
+
+		_, err := client.Resource(gvr).List(t.Context(), metav1.ListOptions{})
+		require.True(t, apierrors.IsNotFound(err))
+
+		_, err = client.Resource(gvr).Namespace("default").Get(t.Context(), "test", metav1.GetOptions{})
+		require.True(t, apierrors.IsNotFound(err))
+
+		_, err = client.Resource(gvr).Watch(t.Context(), metav1.ListOptions{})
+		require.True(t, apierrors.IsNotFound(err))
+	})
+}
+
+func TestDiscoveryClient(t *testing.T) {
+	client := loadFixture(t, fixture).DiscoveryClient()
+
+	resources, err := client.ServerResourcesForGroupVersion(telemetriesGVR.GroupVersion().String())
+	require.NoError(t, err)
+	require.Equal(t, []metav1.APIResource{
+		{
+			Name:       "telemetries",
+			Kind:       "Telemetry",
+			Namespaced: true,
+			Verbs:      metav1.Verbs{"get", "list", "watch"},
+		},
+	}, resources.APIResources)
+}
+
+func loadFixture(t *testing.T, data string) *Cluster {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "fixture.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	c, err := Load([]string{path})
+	require.NoError(t, err)
+
+	return c
+}
+
+func objectNames(list *unstructured.UnstructuredList) []string {
+	var names []string
+	for _, obj := range list.Items {
+		names = append(names, obj.GetName())
+	}
+
+	return names
+}
@@ -9,12 +9,380 @@ import (
 	"go.opentelemetry.io/collector/filter"
 )
 
+// KymaModuleStatusStateMetricAttributeKey specifies the key of an attribute for the kyma.module.status.state metric.
+type KymaModuleStatusStateMetricAttributeKey string
+
+const (
+	KymaModuleStatusStateMetricAttributeKeyChannel       KymaModuleStatusStateMetricAttributeKey = "channel"
+	KymaModuleStatusStateMetricAttributeKeyFqdn          KymaModuleStatusStateMetricAttributeKey = "fqdn"
+	KymaModuleStatusStateMetricAttributeKeyGroup         KymaModuleStatusStateMetricAttributeKey = "group"
+	KymaModuleStatusStateMetricAttributeKeyKind          KymaModuleStatusStateMetricAttributeKey = "kind"
+	KymaModuleStatusStateMetricAttributeKeyModule        KymaModuleStatusStateMetricAttributeKey = "module"
+	KymaModuleStatusStateMetricAttributeKeyModuleVersion KymaModuleStatusStateMetricAttributeKey = "module_version"
+	KymaModuleStatusStateMetricAttributeKeyName          KymaModuleStatusStateMetricAttributeKey = "name"
+	KymaModuleStatusStateMetricAttributeKeyNamespace     KymaModuleStatusStateMetricAttributeKey = "namespace"
+	KymaModuleStatusStateMetricAttributeKeyState         KymaModuleStatusStateMetricAttributeKey = "state"
+	KymaModuleStatusStateMetricAttributeKeyVersion       KymaModuleStatusStateMetricAttributeKey = "version"
+)
+
+// KymaModuleStatusStateMetricConfig provides config for the kyma.module.status.state metric.
+type KymaModuleStatusStateMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                    `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []KymaModuleStatusStateMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *KymaModuleStatusStateMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *KymaModuleStatusStateMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case KymaModuleStatusStateMetricAttributeKeyChannel, KymaModuleStatusStateMetricAttributeKeyFqdn, KymaModuleStatusStateMetricAttributeKeyGroup, KymaModuleStatusStateMetricAttributeKeyKind, KymaModuleStatusStateMetricAttributeKeyModule, KymaModuleStatusStateMetricAttributeKeyModuleVersion, KymaModuleStatusStateMetricAttributeKeyName, KymaModuleStatusStateMetricAttributeKeyNamespace, KymaModuleStatusStateMetricAttributeKeyState, KymaModuleStatusStateMetricAttributeKeyVersion:
+		default:
+			return fmt.Errorf("metric kyma.module.status.state doesn't have an attribute %v, valid attributes: [channel, fqdn, group, kind, module, module_version, name, namespace, state, version]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// KymaReceiverLeaderMetricAttributeKey specifies the key of an attribute for the kyma.receiver.leader metric.
+type KymaReceiverLeaderMetricAttributeKey string
+
+const (
+	KymaReceiverLeaderMetricAttributeKeyReplica KymaReceiverLeaderMetricAttributeKey = "replica"
+)
+
+// KymaReceiverLeaderMetricConfig provides config for the kyma.receiver.leader metric.
+type KymaReceiverLeaderMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                 `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []KymaReceiverLeaderMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *KymaReceiverLeaderMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *KymaReceiverLeaderMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case KymaReceiverLeaderMetricAttributeKeyReplica:
+		default:
+			return fmt.Errorf("metric kyma.receiver.leader doesn't have an attribute %v, valid attributes: [replica]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// KymaResourceConditionCountMetricAttributeKey specifies the key of an attribute for the kyma.resource.condition.count metric.
+type KymaResourceConditionCountMetricAttributeKey string
+
+const (
+	KymaResourceConditionCountMetricAttributeKeyGroup     KymaResourceConditionCountMetricAttributeKey = "group"
+	KymaResourceConditionCountMetricAttributeKeyKind      KymaResourceConditionCountMetricAttributeKey = "kind"
+	KymaResourceConditionCountMetricAttributeKeyNamespace KymaResourceConditionCountMetricAttributeKey = "namespace"
+	KymaResourceConditionCountMetricAttributeKeyStatus    KymaResourceConditionCountMetricAttributeKey = "status"
+	KymaResourceConditionCountMetricAttributeKeyType      KymaResourceConditionCountMetricAttributeKey = "type"
+	KymaResourceConditionCountMetricAttributeKeyVersion   KymaResourceConditionCountMetricAttributeKey = "version"
+)
+
+// KymaResourceConditionCountMetricConfig provides config for the kyma.resource.condition.count metric.
+type KymaResourceConditionCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                         `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []KymaResourceConditionCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *KymaResourceConditionCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *KymaResourceConditionCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case KymaResourceConditionCountMetricAttributeKeyGroup, KymaResourceConditionCountMetricAttributeKeyKind, KymaResourceConditionCountMetricAttributeKeyNamespace, KymaResourceConditionCountMetricAttributeKeyStatus, KymaResourceConditionCountMetricAttributeKeyType, KymaResourceConditionCountMetricAttributeKeyVersion:
+		default:
+			return fmt.Errorf("metric kyma.resource.condition.count doesn't have an attribute %v, valid attributes: [group, kind, namespace, status, type, version]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// KymaResourceCountMetricAttributeKey specifies the key of an attribute for the kyma.resource.count metric.
+type KymaResourceCountMetricAttributeKey string
+
+const (
+	KymaResourceCountMetricAttributeKeyGroup     KymaResourceCountMetricAttributeKey = "group"
+	KymaResourceCountMetricAttributeKeyKind      KymaResourceCountMetricAttributeKey = "kind"
+	KymaResourceCountMetricAttributeKeyNamespace KymaResourceCountMetricAttributeKey = "namespace"
+	KymaResourceCountMetricAttributeKeyState     KymaResourceCountMetricAttributeKey = "state"
+	KymaResourceCountMetricAttributeKeyVersion   KymaResourceCountMetricAttributeKey = "version"
+)
+
+// KymaResourceCountMetricConfig provides config for the kyma.resource.count metric.
+type KymaResourceCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []KymaResourceCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *KymaResourceCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *KymaResourceCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case KymaResourceCountMetricAttributeKeyGroup, KymaResourceCountMetricAttributeKeyKind, KymaResourceCountMetricAttributeKeyNamespace, KymaResourceCountMetricAttributeKeyState, KymaResourceCountMetricAttributeKeyVersion:
+		default:
+			return fmt.Errorf("metric kyma.resource.count doesn't have an attribute %v, valid attributes: [group, kind, namespace, state, version]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// KymaResourceDeletionPendingMetricAttributeKey specifies the key of an attribute for the kyma.resource.deletion.pending metric.
+type KymaResourceDeletionPendingMetricAttributeKey string
+
+const (
+	KymaResourceDeletionPendingMetricAttributeKeyFinalizers KymaResourceDeletionPendingMetricAttributeKey = "finalizers"
+	KymaResourceDeletionPendingMetricAttributeKeyGroup      KymaResourceDeletionPendingMetricAttributeKey = "group"
+	KymaResourceDeletionPendingMetricAttributeKeyKind       KymaResourceDeletionPendingMetricAttributeKey = "kind"
+	KymaResourceDeletionPendingMetricAttributeKeyName       KymaResourceDeletionPendingMetricAttributeKey = "name"
+	KymaResourceDeletionPendingMetricAttributeKeyNamespace  KymaResourceDeletionPendingMetricAttributeKey = "namespace"
+	KymaResourceDeletionPendingMetricAttributeKeyVersion    KymaResourceDeletionPendingMetricAttributeKey = "version"
+)
+
+// KymaResourceDeletionPendingMetricConfig provides config for the kyma.resource.deletion.pending metric.
+type KymaResourceDeletionPendingMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                          `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []KymaResourceDeletionPendingMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *KymaResourceDeletionPendingMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *KymaResourceDeletionPendingMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case KymaResourceDeletionPendingMetricAttributeKeyFinalizers, KymaResourceDeletionPendingMetricAttributeKeyGroup, KymaResourceDeletionPendingMetricAttributeKeyKind, KymaResourceDeletionPendingMetricAttributeKeyName, KymaResourceDeletionPendingMetricAttributeKeyNamespace, KymaResourceDeletionPendingMetricAttributeKeyVersion:
+		default:
+			return fmt.Errorf("metric kyma.resource.deletion.pending doesn't have an attribute %v, valid attributes: [finalizers, group, kind, name, namespace, version]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// KymaResourceScrapeErrorsMetricAttributeKey specifies the key of an attribute for the kyma.resource.scrape.errors metric.
+type KymaResourceScrapeErrorsMetricAttributeKey string
+
+const (
+	KymaResourceScrapeErrorsMetricAttributeKeyGroup    KymaResourceScrapeErrorsMetricAttributeKey = "group"
+	KymaResourceScrapeErrorsMetricAttributeKeyResource KymaResourceScrapeErrorsMetricAttributeKey = "resource"
+	KymaResourceScrapeErrorsMetricAttributeKeyVersion  KymaResourceScrapeErrorsMetricAttributeKey = "version"
+)
+
+// KymaResourceScrapeErrorsMetricConfig provides config for the kyma.resource.scrape.errors metric.
+type KymaResourceScrapeErrorsMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                       `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []KymaResourceScrapeErrorsMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *KymaResourceScrapeErrorsMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *KymaResourceScrapeErrorsMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case KymaResourceScrapeErrorsMetricAttributeKeyGroup, KymaResourceScrapeErrorsMetricAttributeKeyResource, KymaResourceScrapeErrorsMetricAttributeKeyVersion:
+		default:
+			return fmt.Errorf("metric kyma.resource.scrape.errors doesn't have an attribute %v, valid attributes: [group, resource, version]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// KymaResourceStatusConditionLastTransitionMetricAttributeKey specifies the key of an attribute for the kyma.resource.status.condition.last_transition metric.
+type KymaResourceStatusConditionLastTransitionMetricAttributeKey string
+
+const (
+	KymaResourceStatusConditionLastTransitionMetricAttributeKeyGroup     KymaResourceStatusConditionLastTransitionMetricAttributeKey = "group"
+	KymaResourceStatusConditionLastTransitionMetricAttributeKeyKind      KymaResourceStatusConditionLastTransitionMetricAttributeKey = "kind"
+	KymaResourceStatusConditionLastTransitionMetricAttributeKeyName      KymaResourceStatusConditionLastTransitionMetricAttributeKey = "name"
+	KymaResourceStatusConditionLastTransitionMetricAttributeKeyNamespace KymaResourceStatusConditionLastTransitionMetricAttributeKey = "namespace"
+	KymaResourceStatusConditionLastTransitionMetricAttributeKeyStatus    KymaResourceStatusConditionLastTransitionMetricAttributeKey = "status"
+	KymaResourceStatusConditionLastTransitionMetricAttributeKeyType      KymaResourceStatusConditionLastTransitionMetricAttributeKey = "type"
+	KymaResourceStatusConditionLastTransitionMetricAttributeKeyVersion   KymaResourceStatusConditionLastTransitionMetricAttributeKey = "version"
+)
+
+// KymaResourceStatusConditionLastTransitionMetricConfig provides config for the kyma.resource.status.condition.last_transition metric.
+type KymaResourceStatusConditionLastTransitionMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                                        `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []KymaResourceStatusConditionLastTransitionMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *KymaResourceStatusConditionLastTransitionMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *KymaResourceStatusConditionLastTransitionMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case KymaResourceStatusConditionLastTransitionMetricAttributeKeyGroup, KymaResourceStatusConditionLastTransitionMetricAttributeKeyKind, KymaResourceStatusConditionLastTransitionMetricAttributeKeyName, KymaResourceStatusConditionLastTransitionMetricAttributeKeyNamespace, KymaResourceStatusConditionLastTransitionMetricAttributeKeyStatus, KymaResourceStatusConditionLastTransitionMetricAttributeKeyType, KymaResourceStatusConditionLastTransitionMetricAttributeKeyVersion:
+		default:
+			return fmt.Errorf("metric kyma.resource.status.condition.last_transition doesn't have an attribute %v, valid attributes: [group, kind, name, namespace, status, type, version]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // KymaResourceStatusConditionsMetricAttributeKey specifies the key of an attribute for the kyma.resource.status.conditions metric.
 type KymaResourceStatusConditionsMetricAttributeKey string
 
 const (
 	KymaResourceStatusConditionsMetricAttributeKeyGroup     KymaResourceStatusConditionsMetricAttributeKey = "group"
 	KymaResourceStatusConditionsMetricAttributeKeyKind      KymaResourceStatusConditionsMetricAttributeKey = "kind"
+	KymaResourceStatusConditionsMetricAttributeKeyMessage   KymaResourceStatusConditionsMetricAttributeKey = "message"
 	KymaResourceStatusConditionsMetricAttributeKeyName      KymaResourceStatusConditionsMetricAttributeKey = "name"
 	KymaResourceStatusConditionsMetricAttributeKeyNamespace KymaResourceStatusConditionsMetricAttributeKey = "namespace"
 	KymaResourceStatusConditionsMetricAttributeKeyReason    KymaResourceStatusConditionsMetricAttributeKey = "reason"
@@ -49,9 +417,113 @@ func (ms *KymaResourceStatusConditionsMetricConfig) Unmarshal(parser *confmap.Co
 func (ms *KymaResourceStatusConditionsMetricConfig) Validate() error {
 	for _, val := range ms.EnabledAttributes {
 		switch val {
-		case KymaResourceStatusConditionsMetricAttributeKeyGroup, KymaResourceStatusConditionsMetricAttributeKeyKind, KymaResourceStatusConditionsMetricAttributeKeyName, KymaResourceStatusConditionsMetricAttributeKeyNamespace, KymaResourceStatusConditionsMetricAttributeKeyReason, KymaResourceStatusConditionsMetricAttributeKeyStatus, KymaResourceStatusConditionsMetricAttributeKeyType, KymaResourceStatusConditionsMetricAttributeKeyVersion:
+		case KymaResourceStatusConditionsMetricAttributeKeyGroup, KymaResourceStatusConditionsMetricAttributeKeyKind, KymaResourceStatusConditionsMetricAttributeKeyMessage, KymaResourceStatusConditionsMetricAttributeKeyName, KymaResourceStatusConditionsMetricAttributeKeyNamespace, KymaResourceStatusConditionsMetricAttributeKeyReason, KymaResourceStatusConditionsMetricAttributeKeyStatus, KymaResourceStatusConditionsMetricAttributeKeyType, KymaResourceStatusConditionsMetricAttributeKeyVersion:
+		default:
+			return fmt.Errorf("metric kyma.resource.status.conditions doesn't have an attribute %v, valid attributes: [group, kind, message, name, namespace, reason, status, type, version]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// KymaResourceStatusGenerationLagMetricAttributeKey specifies the key of an attribute for the kyma.resource.status.generation_lag metric.
+type KymaResourceStatusGenerationLagMetricAttributeKey string
+
+const (
+	KymaResourceStatusGenerationLagMetricAttributeKeyGroup     KymaResourceStatusGenerationLagMetricAttributeKey = "group"
+	KymaResourceStatusGenerationLagMetricAttributeKeyKind      KymaResourceStatusGenerationLagMetricAttributeKey = "kind"
+	KymaResourceStatusGenerationLagMetricAttributeKeyName      KymaResourceStatusGenerationLagMetricAttributeKey = "name"
+	KymaResourceStatusGenerationLagMetricAttributeKeyNamespace KymaResourceStatusGenerationLagMetricAttributeKey = "namespace"
+	KymaResourceStatusGenerationLagMetricAttributeKeyVersion   KymaResourceStatusGenerationLagMetricAttributeKey = "version"
+)
+
+// KymaResourceStatusGenerationLagMetricConfig provides config for the kyma.resource.status.generation_lag metric.
+type KymaResourceStatusGenerationLagMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                              `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []KymaResourceStatusGenerationLagMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *KymaResourceStatusGenerationLagMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *KymaResourceStatusGenerationLagMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case KymaResourceStatusGenerationLagMetricAttributeKeyGroup, KymaResourceStatusGenerationLagMetricAttributeKeyKind, KymaResourceStatusGenerationLagMetricAttributeKeyName, KymaResourceStatusGenerationLagMetricAttributeKeyNamespace, KymaResourceStatusGenerationLagMetricAttributeKeyVersion:
+		default:
+			return fmt.Errorf("metric kyma.resource.status.generation_lag doesn't have an attribute %v, valid attributes: [group, kind, name, namespace, version]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// KymaResourceStatusReconciledMetricAttributeKey specifies the key of an attribute for the kyma.resource.status.reconciled metric.
+type KymaResourceStatusReconciledMetricAttributeKey string
+
+const (
+	KymaResourceStatusReconciledMetricAttributeKeyGroup     KymaResourceStatusReconciledMetricAttributeKey = "group"
+	KymaResourceStatusReconciledMetricAttributeKeyKind      KymaResourceStatusReconciledMetricAttributeKey = "kind"
+	KymaResourceStatusReconciledMetricAttributeKeyName      KymaResourceStatusReconciledMetricAttributeKey = "name"
+	KymaResourceStatusReconciledMetricAttributeKeyNamespace KymaResourceStatusReconciledMetricAttributeKey = "namespace"
+	KymaResourceStatusReconciledMetricAttributeKeyVersion   KymaResourceStatusReconciledMetricAttributeKey = "version"
+)
+
+// KymaResourceStatusReconciledMetricConfig provides config for the kyma.resource.status.reconciled metric.
+type KymaResourceStatusReconciledMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                           `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []KymaResourceStatusReconciledMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *KymaResourceStatusReconciledMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *KymaResourceStatusReconciledMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case KymaResourceStatusReconciledMetricAttributeKeyGroup, KymaResourceStatusReconciledMetricAttributeKeyKind, KymaResourceStatusReconciledMetricAttributeKeyName, KymaResourceStatusReconciledMetricAttributeKeyNamespace, KymaResourceStatusReconciledMetricAttributeKeyVersion:
 		default:
-			return fmt.Errorf("metric kyma.resource.status.conditions doesn't have an attribute %v, valid attributes: [group, kind, name, namespace, reason, status, type, version]", val)
+			return fmt.Errorf("metric kyma.resource.status.reconciled doesn't have an attribute %v, valid attributes: [group, kind, name, namespace, version]", val)
 		}
 	}
 
@@ -119,17 +591,71 @@ func (ms *KymaResourceStatusStateMetricConfig) Validate() error {
 
 // MetricsConfig provides config for kymastats metrics.
 type MetricsConfig struct {
-	KymaResourceStatusConditions KymaResourceStatusConditionsMetricConfig `mapstructure:"kyma.resource.status.conditions"`
-	KymaResourceStatusState      KymaResourceStatusStateMetricConfig      `mapstructure:"kyma.resource.status.state"`
+	KymaModuleStatusState                     KymaModuleStatusStateMetricConfig                     `mapstructure:"kyma.module.status.state"`
+	KymaReceiverLeader                        KymaReceiverLeaderMetricConfig                        `mapstructure:"kyma.receiver.leader"`
+	KymaResourceConditionCount                KymaResourceConditionCountMetricConfig                `mapstructure:"kyma.resource.condition.count"`
+	KymaResourceCount                         KymaResourceCountMetricConfig                         `mapstructure:"kyma.resource.count"`
+	KymaResourceDeletionPending               KymaResourceDeletionPendingMetricConfig               `mapstructure:"kyma.resource.deletion.pending"`
+	KymaResourceScrapeErrors                  KymaResourceScrapeErrorsMetricConfig                  `mapstructure:"kyma.resource.scrape.errors"`
+	KymaResourceStatusConditionLastTransition KymaResourceStatusConditionLastTransitionMetricConfig `mapstructure:"kyma.resource.status.condition.last_transition"`
+	KymaResourceStatusConditions              KymaResourceStatusConditionsMetricConfig              `mapstructure:"kyma.resource.status.conditions"`
+	KymaResourceStatusGenerationLag           KymaResourceStatusGenerationLagMetricConfig           `mapstructure:"kyma.resource.status.generation_lag"`
+	KymaResourceStatusReconciled              KymaResourceStatusReconciledMetricConfig              `mapstructure:"kyma.resource.status.reconciled"`
+	KymaResourceStatusState                   KymaResourceStatusStateMetricConfig                   `mapstructure:"kyma.resource.status.state"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		KymaModuleStatusState: KymaModuleStatusStateMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []KymaModuleStatusStateMetricAttributeKey{KymaModuleStatusStateMetricAttributeKeyChannel, KymaModuleStatusStateMetricAttributeKeyFqdn, KymaModuleStatusStateMetricAttributeKeyGroup, KymaModuleStatusStateMetricAttributeKeyKind, KymaModuleStatusStateMetricAttributeKeyModule, KymaModuleStatusStateMetricAttributeKeyModuleVersion, KymaModuleStatusStateMetricAttributeKeyName, KymaModuleStatusStateMetricAttributeKeyNamespace, KymaModuleStatusStateMetricAttributeKeyState, KymaModuleStatusStateMetricAttributeKeyVersion},
+		},
+		KymaReceiverLeader: KymaReceiverLeaderMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []KymaReceiverLeaderMetricAttributeKey{KymaReceiverLeaderMetricAttributeKeyReplica},
+		},
+		KymaResourceConditionCount: KymaResourceConditionCountMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []KymaResourceConditionCountMetricAttributeKey{KymaResourceConditionCountMetricAttributeKeyGroup, KymaResourceConditionCountMetricAttributeKeyKind, KymaResourceConditionCountMetricAttributeKeyNamespace, KymaResourceConditionCountMetricAttributeKeyStatus, KymaResourceConditionCountMetricAttributeKeyType, KymaResourceConditionCountMetricAttributeKeyVersion},
+		},
+		KymaResourceCount: KymaResourceCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []KymaResourceCountMetricAttributeKey{KymaResourceCountMetricAttributeKeyGroup, KymaResourceCountMetricAttributeKeyKind, KymaResourceCountMetricAttributeKeyNamespace, KymaResourceCountMetricAttributeKeyState, KymaResourceCountMetricAttributeKeyVersion},
+		},
+		KymaResourceDeletionPending: KymaResourceDeletionPendingMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []KymaResourceDeletionPendingMetricAttributeKey{KymaResourceDeletionPendingMetricAttributeKeyFinalizers, KymaResourceDeletionPendingMetricAttributeKeyGroup, KymaResourceDeletionPendingMetricAttributeKeyKind, KymaResourceDeletionPendingMetricAttributeKeyName, KymaResourceDeletionPendingMetricAttributeKeyNamespace, KymaResourceDeletionPendingMetricAttributeKeyVersion},
+		},
+		KymaResourceScrapeErrors: KymaResourceScrapeErrorsMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []KymaResourceScrapeErrorsMetricAttributeKey{KymaResourceScrapeErrorsMetricAttributeKeyGroup, KymaResourceScrapeErrorsMetricAttributeKeyResource, KymaResourceScrapeErrorsMetricAttributeKeyVersion},
+		},
+		KymaResourceStatusConditionLastTransition: KymaResourceStatusConditionLastTransitionMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []KymaResourceStatusConditionLastTransitionMetricAttributeKey{KymaResourceStatusConditionLastTransitionMetricAttributeKeyGroup, KymaResourceStatusConditionLastTransitionMetricAttributeKeyKind, KymaResourceStatusConditionLastTransitionMetricAttributeKeyName, KymaResourceStatusConditionLastTransitionMetricAttributeKeyNamespace, KymaResourceStatusConditionLastTransitionMetricAttributeKeyStatus, KymaResourceStatusConditionLastTransitionMetricAttributeKeyType, KymaResourceStatusConditionLastTransitionMetricAttributeKeyVersion},
+		},
 		KymaResourceStatusConditions: KymaResourceStatusConditionsMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategyAvg,
 			EnabledAttributes:   []KymaResourceStatusConditionsMetricAttributeKey{KymaResourceStatusConditionsMetricAttributeKeyGroup, KymaResourceStatusConditionsMetricAttributeKeyKind, KymaResourceStatusConditionsMetricAttributeKeyName, KymaResourceStatusConditionsMetricAttributeKeyNamespace, KymaResourceStatusConditionsMetricAttributeKeyReason, KymaResourceStatusConditionsMetricAttributeKeyStatus, KymaResourceStatusConditionsMetricAttributeKeyType, KymaResourceStatusConditionsMetricAttributeKeyVersion},
 		},
+		KymaResourceStatusGenerationLag: KymaResourceStatusGenerationLagMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []KymaResourceStatusGenerationLagMetricAttributeKey{KymaResourceStatusGenerationLagMetricAttributeKeyGroup, KymaResourceStatusGenerationLagMetricAttributeKeyKind, KymaResourceStatusGenerationLagMetricAttributeKeyName, KymaResourceStatusGenerationLagMetricAttributeKeyNamespace, KymaResourceStatusGenerationLagMetricAttributeKeyVersion},
+		},
+		KymaResourceStatusReconciled: KymaResourceStatusReconciledMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []KymaResourceStatusReconciledMetricAttributeKey{KymaResourceStatusReconciledMetricAttributeKeyGroup, KymaResourceStatusReconciledMetricAttributeKeyKind, KymaResourceStatusReconciledMetricAttributeKeyName, KymaResourceStatusReconciledMetricAttributeKeyNamespace, KymaResourceStatusReconciledMetricAttributeKeyVersion},
+		},
 		KymaResourceStatusState: KymaResourceStatusStateMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategyAvg,
@@ -166,15 +692,31 @@ func (rac *ResourceAttributeConfig) Unmarshal(parser *confmap.Conf) error {
 
 // ResourceAttributesConfig provides config for kymastats resource attributes.
 type ResourceAttributesConfig struct {
-	K8sNamespaceName   ResourceAttributeConfig `mapstructure:"k8s.namespace.name"`
-	K8sResourceGroup   ResourceAttributeConfig `mapstructure:"k8s.resource.group"`
-	K8sResourceKind    ResourceAttributeConfig `mapstructure:"k8s.resource.kind"`
-	K8sResourceName    ResourceAttributeConfig `mapstructure:"k8s.resource.name"`
-	K8sResourceVersion ResourceAttributeConfig `mapstructure:"k8s.resource.version"`
+	K8sClusterName          ResourceAttributeConfig `mapstructure:"k8s.cluster.name"`
+	K8sClusterUID           ResourceAttributeConfig `mapstructure:"k8s.cluster.uid"`
+	K8sNamespaceName        ResourceAttributeConfig `mapstructure:"k8s.namespace.name"`
+	K8sResourceGroup        ResourceAttributeConfig `mapstructure:"k8s.resource.group"`
+	K8sResourceKind         ResourceAttributeConfig `mapstructure:"k8s.resource.kind"`
+	K8sResourceName         ResourceAttributeConfig `mapstructure:"k8s.resource.name"`
+	K8sResourceOwnerKind    ResourceAttributeConfig `mapstructure:"k8s.resource.owner.kind"`
+	K8sResourceOwnerName    ResourceAttributeConfig `mapstructure:"k8s.resource.owner.name"`
+	K8sResourceOwnerUID     ResourceAttributeConfig `mapstructure:"k8s.resource.owner.uid"`
+	K8sResourcePlural       ResourceAttributeConfig `mapstructure:"k8s.resource.plural"`
+	K8sResourceTopOwnerKind ResourceAttributeConfig `mapstructure:"k8s.resource.top_owner.kind"`
+	K8sResourceTopOwnerName ResourceAttributeConfig `mapstructure:"k8s.resource.top_owner.name"`
+	K8sResourceTopOwnerUID  ResourceAttributeConfig `mapstructure:"k8s.resource.top_owner.uid"`
+	K8sResourceVersion      ResourceAttributeConfig `mapstructure:"k8s.resource.version"`
+	KymaReceiverShard       ResourceAttributeConfig `mapstructure:"kyma.receiver.shard"`
 }
 
 func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 	return ResourceAttributesConfig{
+		K8sClusterName: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sClusterUID: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		K8sNamespaceName: ResourceAttributeConfig{
 			Enabled: true,
 		},
@@ -187,9 +729,33 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sResourceName: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		K8sResourceOwnerKind: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sResourceOwnerName: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sResourceOwnerUID: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sResourcePlural: ResourceAttributeConfig{
+			Enabled: true,
+		},
+		K8sResourceTopOwnerKind: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sResourceTopOwnerName: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sResourceTopOwnerUID: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		K8sResourceVersion: ResourceAttributeConfig{
 			Enabled: true,
 		},
+		KymaReceiverShard: ResourceAttributeConfig{
+			Enabled: true,
+		},
 	}
 }
 
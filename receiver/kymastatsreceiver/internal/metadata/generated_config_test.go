@@ -26,10 +26,55 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					KymaModuleStatusState: KymaModuleStatusStateMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaModuleStatusStateMetricAttributeKey{KymaModuleStatusStateMetricAttributeKeyChannel, KymaModuleStatusStateMetricAttributeKeyFqdn, KymaModuleStatusStateMetricAttributeKeyGroup, KymaModuleStatusStateMetricAttributeKeyKind, KymaModuleStatusStateMetricAttributeKeyModule, KymaModuleStatusStateMetricAttributeKeyModuleVersion, KymaModuleStatusStateMetricAttributeKeyName, KymaModuleStatusStateMetricAttributeKeyNamespace, KymaModuleStatusStateMetricAttributeKeyState, KymaModuleStatusStateMetricAttributeKeyVersion},
+					},
+					KymaReceiverLeader: KymaReceiverLeaderMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaReceiverLeaderMetricAttributeKey{KymaReceiverLeaderMetricAttributeKeyReplica},
+					},
+					KymaResourceConditionCount: KymaResourceConditionCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceConditionCountMetricAttributeKey{KymaResourceConditionCountMetricAttributeKeyGroup, KymaResourceConditionCountMetricAttributeKeyKind, KymaResourceConditionCountMetricAttributeKeyNamespace, KymaResourceConditionCountMetricAttributeKeyStatus, KymaResourceConditionCountMetricAttributeKeyType, KymaResourceConditionCountMetricAttributeKeyVersion},
+					},
+					KymaResourceCount: KymaResourceCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceCountMetricAttributeKey{KymaResourceCountMetricAttributeKeyGroup, KymaResourceCountMetricAttributeKeyKind, KymaResourceCountMetricAttributeKeyNamespace, KymaResourceCountMetricAttributeKeyState, KymaResourceCountMetricAttributeKeyVersion},
+					},
+					KymaResourceDeletionPending: KymaResourceDeletionPendingMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceDeletionPendingMetricAttributeKey{KymaResourceDeletionPendingMetricAttributeKeyFinalizers, KymaResourceDeletionPendingMetricAttributeKeyGroup, KymaResourceDeletionPendingMetricAttributeKeyKind, KymaResourceDeletionPendingMetricAttributeKeyName, KymaResourceDeletionPendingMetricAttributeKeyNamespace, KymaResourceDeletionPendingMetricAttributeKeyVersion},
+					},
+					KymaResourceScrapeErrors: KymaResourceScrapeErrorsMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceScrapeErrorsMetricAttributeKey{KymaResourceScrapeErrorsMetricAttributeKeyGroup, KymaResourceScrapeErrorsMetricAttributeKeyResource, KymaResourceScrapeErrorsMetricAttributeKeyVersion},
+					},
+					KymaResourceStatusConditionLastTransition: KymaResourceStatusConditionLastTransitionMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceStatusConditionLastTransitionMetricAttributeKey{KymaResourceStatusConditionLastTransitionMetricAttributeKeyGroup, KymaResourceStatusConditionLastTransitionMetricAttributeKeyKind, KymaResourceStatusConditionLastTransitionMetricAttributeKeyName, KymaResourceStatusConditionLastTransitionMetricAttributeKeyNamespace, KymaResourceStatusConditionLastTransitionMetricAttributeKeyStatus, KymaResourceStatusConditionLastTransitionMetricAttributeKeyType, KymaResourceStatusConditionLastTransitionMetricAttributeKeyVersion},
+					},
 					KymaResourceStatusConditions: KymaResourceStatusConditionsMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategyAvg,
-						EnabledAttributes:   []KymaResourceStatusConditionsMetricAttributeKey{KymaResourceStatusConditionsMetricAttributeKeyGroup, KymaResourceStatusConditionsMetricAttributeKeyKind, KymaResourceStatusConditionsMetricAttributeKeyName, KymaResourceStatusConditionsMetricAttributeKeyNamespace, KymaResourceStatusConditionsMetricAttributeKeyReason, KymaResourceStatusConditionsMetricAttributeKeyStatus, KymaResourceStatusConditionsMetricAttributeKeyType, KymaResourceStatusConditionsMetricAttributeKeyVersion},
+						EnabledAttributes:   []KymaResourceStatusConditionsMetricAttributeKey{KymaResourceStatusConditionsMetricAttributeKeyGroup, KymaResourceStatusConditionsMetricAttributeKeyKind, KymaResourceStatusConditionsMetricAttributeKeyMessage, KymaResourceStatusConditionsMetricAttributeKeyName, KymaResourceStatusConditionsMetricAttributeKeyNamespace, KymaResourceStatusConditionsMetricAttributeKeyReason, KymaResourceStatusConditionsMetricAttributeKeyStatus, KymaResourceStatusConditionsMetricAttributeKeyType, KymaResourceStatusConditionsMetricAttributeKeyVersion},
+					},
+					KymaResourceStatusGenerationLag: KymaResourceStatusGenerationLagMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceStatusGenerationLagMetricAttributeKey{KymaResourceStatusGenerationLagMetricAttributeKeyGroup, KymaResourceStatusGenerationLagMetricAttributeKeyKind, KymaResourceStatusGenerationLagMetricAttributeKeyName, KymaResourceStatusGenerationLagMetricAttributeKeyNamespace, KymaResourceStatusGenerationLagMetricAttributeKeyVersion},
+					},
+					KymaResourceStatusReconciled: KymaResourceStatusReconciledMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceStatusReconciledMetricAttributeKey{KymaResourceStatusReconciledMetricAttributeKeyGroup, KymaResourceStatusReconciledMetricAttributeKeyKind, KymaResourceStatusReconciledMetricAttributeKeyName, KymaResourceStatusReconciledMetricAttributeKeyNamespace, KymaResourceStatusReconciledMetricAttributeKeyVersion},
 					},
 					KymaResourceStatusState: KymaResourceStatusStateMetricConfig{
 						Enabled:             true,
@@ -38,11 +83,21 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					K8sNamespaceName:   ResourceAttributeConfig{Enabled: true},
-					K8sResourceGroup:   ResourceAttributeConfig{Enabled: true},
-					K8sResourceKind:    ResourceAttributeConfig{Enabled: true},
-					K8sResourceName:    ResourceAttributeConfig{Enabled: true},
-					K8sResourceVersion: ResourceAttributeConfig{Enabled: true},
+					K8sClusterName:          ResourceAttributeConfig{Enabled: true},
+					K8sClusterUID:           ResourceAttributeConfig{Enabled: true},
+					K8sNamespaceName:        ResourceAttributeConfig{Enabled: true},
+					K8sResourceGroup:        ResourceAttributeConfig{Enabled: true},
+					K8sResourceKind:         ResourceAttributeConfig{Enabled: true},
+					K8sResourceName:         ResourceAttributeConfig{Enabled: true},
+					K8sResourceOwnerKind:    ResourceAttributeConfig{Enabled: true},
+					K8sResourceOwnerName:    ResourceAttributeConfig{Enabled: true},
+					K8sResourceOwnerUID:     ResourceAttributeConfig{Enabled: true},
+					K8sResourcePlural:       ResourceAttributeConfig{Enabled: true},
+					K8sResourceTopOwnerKind: ResourceAttributeConfig{Enabled: true},
+					K8sResourceTopOwnerName: ResourceAttributeConfig{Enabled: true},
+					K8sResourceTopOwnerUID:  ResourceAttributeConfig{Enabled: true},
+					K8sResourceVersion:      ResourceAttributeConfig{Enabled: true},
+					KymaReceiverShard:       ResourceAttributeConfig{Enabled: true},
 				},
 			},
 		},
@@ -50,10 +105,55 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					KymaModuleStatusState: KymaModuleStatusStateMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaModuleStatusStateMetricAttributeKey{KymaModuleStatusStateMetricAttributeKeyChannel, KymaModuleStatusStateMetricAttributeKeyFqdn, KymaModuleStatusStateMetricAttributeKeyGroup, KymaModuleStatusStateMetricAttributeKeyKind, KymaModuleStatusStateMetricAttributeKeyModule, KymaModuleStatusStateMetricAttributeKeyModuleVersion, KymaModuleStatusStateMetricAttributeKeyName, KymaModuleStatusStateMetricAttributeKeyNamespace, KymaModuleStatusStateMetricAttributeKeyState, KymaModuleStatusStateMetricAttributeKeyVersion},
+					},
+					KymaReceiverLeader: KymaReceiverLeaderMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaReceiverLeaderMetricAttributeKey{KymaReceiverLeaderMetricAttributeKeyReplica},
+					},
+					KymaResourceConditionCount: KymaResourceConditionCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceConditionCountMetricAttributeKey{KymaResourceConditionCountMetricAttributeKeyGroup, KymaResourceConditionCountMetricAttributeKeyKind, KymaResourceConditionCountMetricAttributeKeyNamespace, KymaResourceConditionCountMetricAttributeKeyStatus, KymaResourceConditionCountMetricAttributeKeyType, KymaResourceConditionCountMetricAttributeKeyVersion},
+					},
+					KymaResourceCount: KymaResourceCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceCountMetricAttributeKey{KymaResourceCountMetricAttributeKeyGroup, KymaResourceCountMetricAttributeKeyKind, KymaResourceCountMetricAttributeKeyNamespace, KymaResourceCountMetricAttributeKeyState, KymaResourceCountMetricAttributeKeyVersion},
+					},
+					KymaResourceDeletionPending: KymaResourceDeletionPendingMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceDeletionPendingMetricAttributeKey{KymaResourceDeletionPendingMetricAttributeKeyFinalizers, KymaResourceDeletionPendingMetricAttributeKeyGroup, KymaResourceDeletionPendingMetricAttributeKeyKind, KymaResourceDeletionPendingMetricAttributeKeyName, KymaResourceDeletionPendingMetricAttributeKeyNamespace, KymaResourceDeletionPendingMetricAttributeKeyVersion},
+					},
+					KymaResourceScrapeErrors: KymaResourceScrapeErrorsMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceScrapeErrorsMetricAttributeKey{KymaResourceScrapeErrorsMetricAttributeKeyGroup, KymaResourceScrapeErrorsMetricAttributeKeyResource, KymaResourceScrapeErrorsMetricAttributeKeyVersion},
+					},
+					KymaResourceStatusConditionLastTransition: KymaResourceStatusConditionLastTransitionMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceStatusConditionLastTransitionMetricAttributeKey{KymaResourceStatusConditionLastTransitionMetricAttributeKeyGroup, KymaResourceStatusConditionLastTransitionMetricAttributeKeyKind, KymaResourceStatusConditionLastTransitionMetricAttributeKeyName, KymaResourceStatusConditionLastTransitionMetricAttributeKeyNamespace, KymaResourceStatusConditionLastTransitionMetricAttributeKeyStatus, KymaResourceStatusConditionLastTransitionMetricAttributeKeyType, KymaResourceStatusConditionLastTransitionMetricAttributeKeyVersion},
+					},
 					KymaResourceStatusConditions: KymaResourceStatusConditionsMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategyAvg,
-						EnabledAttributes:   []KymaResourceStatusConditionsMetricAttributeKey{KymaResourceStatusConditionsMetricAttributeKeyGroup, KymaResourceStatusConditionsMetricAttributeKeyKind, KymaResourceStatusConditionsMetricAttributeKeyName, KymaResourceStatusConditionsMetricAttributeKeyNamespace, KymaResourceStatusConditionsMetricAttributeKeyReason, KymaResourceStatusConditionsMetricAttributeKeyStatus, KymaResourceStatusConditionsMetricAttributeKeyType, KymaResourceStatusConditionsMetricAttributeKeyVersion},
+						EnabledAttributes:   []KymaResourceStatusConditionsMetricAttributeKey{KymaResourceStatusConditionsMetricAttributeKeyGroup, KymaResourceStatusConditionsMetricAttributeKeyKind, KymaResourceStatusConditionsMetricAttributeKeyMessage, KymaResourceStatusConditionsMetricAttributeKeyName, KymaResourceStatusConditionsMetricAttributeKeyNamespace, KymaResourceStatusConditionsMetricAttributeKeyReason, KymaResourceStatusConditionsMetricAttributeKeyStatus, KymaResourceStatusConditionsMetricAttributeKeyType, KymaResourceStatusConditionsMetricAttributeKeyVersion},
+					},
+					KymaResourceStatusGenerationLag: KymaResourceStatusGenerationLagMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceStatusGenerationLagMetricAttributeKey{KymaResourceStatusGenerationLagMetricAttributeKeyGroup, KymaResourceStatusGenerationLagMetricAttributeKeyKind, KymaResourceStatusGenerationLagMetricAttributeKeyName, KymaResourceStatusGenerationLagMetricAttributeKeyNamespace, KymaResourceStatusGenerationLagMetricAttributeKeyVersion},
+					},
+					KymaResourceStatusReconciled: KymaResourceStatusReconciledMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []KymaResourceStatusReconciledMetricAttributeKey{KymaResourceStatusReconciledMetricAttributeKeyGroup, KymaResourceStatusReconciledMetricAttributeKeyKind, KymaResourceStatusReconciledMetricAttributeKeyName, KymaResourceStatusReconciledMetricAttributeKeyNamespace, KymaResourceStatusReconciledMetricAttributeKeyVersion},
 					},
 					KymaResourceStatusState: KymaResourceStatusStateMetricConfig{
 						Enabled:             false,
@@ -62,11 +162,21 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
-					K8sNamespaceName:   ResourceAttributeConfig{Enabled: false},
-					K8sResourceGroup:   ResourceAttributeConfig{Enabled: false},
-					K8sResourceKind:    ResourceAttributeConfig{Enabled: false},
-					K8sResourceName:    ResourceAttributeConfig{Enabled: false},
-					K8sResourceVersion: ResourceAttributeConfig{Enabled: false},
+					K8sClusterName:          ResourceAttributeConfig{Enabled: false},
+					K8sClusterUID:           ResourceAttributeConfig{Enabled: false},
+					K8sNamespaceName:        ResourceAttributeConfig{Enabled: false},
+					K8sResourceGroup:        ResourceAttributeConfig{Enabled: false},
+					K8sResourceKind:         ResourceAttributeConfig{Enabled: false},
+					K8sResourceName:         ResourceAttributeConfig{Enabled: false},
+					K8sResourceOwnerKind:    ResourceAttributeConfig{Enabled: false},
+					K8sResourceOwnerName:    ResourceAttributeConfig{Enabled: false},
+					K8sResourceOwnerUID:     ResourceAttributeConfig{Enabled: false},
+					K8sResourcePlural:       ResourceAttributeConfig{Enabled: false},
+					K8sResourceTopOwnerKind: ResourceAttributeConfig{Enabled: false},
+					K8sResourceTopOwnerName: ResourceAttributeConfig{Enabled: false},
+					K8sResourceTopOwnerUID:  ResourceAttributeConfig{Enabled: false},
+					K8sResourceVersion:      ResourceAttributeConfig{Enabled: false},
+					KymaReceiverShard:       ResourceAttributeConfig{Enabled: false},
 				},
 			},
 		},
@@ -74,23 +184,131 @@ func TestMetricsBuilderConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := loadMetricsBuilderConfig(t, tt.name)
-			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(KymaResourceStatusConditionsMetricConfig{}, KymaResourceStatusStateMetricConfig{}, ResourceAttributeConfig{}))
+			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(KymaModuleStatusStateMetricConfig{}, KymaReceiverLeaderMetricConfig{}, KymaResourceConditionCountMetricConfig{}, KymaResourceCountMetricConfig{}, KymaResourceDeletionPendingMetricConfig{}, KymaResourceScrapeErrorsMetricConfig{}, KymaResourceStatusConditionLastTransitionMetricConfig{}, KymaResourceStatusConditionsMetricConfig{}, KymaResourceStatusGenerationLagMetricConfig{}, KymaResourceStatusReconciledMetricConfig{}, KymaResourceStatusStateMetricConfig{}, ResourceAttributeConfig{}))
 			require.Emptyf(t, diff, "Config mismatch (-expected +actual):\n%s", diff)
 		})
 	}
 }
+func TestKymaModuleStatusStateMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().KymaModuleStatusState
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []KymaModuleStatusStateMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric kyma.module.status.state doesn't have an attribute invalid, valid attributes: [channel, fqdn, group, kind, module, module_version, name, namespace, state, version]")
+
+	cfg = DefaultMetricsConfig().KymaModuleStatusState
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestKymaReceiverLeaderMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().KymaReceiverLeader
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []KymaReceiverLeaderMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric kyma.receiver.leader doesn't have an attribute invalid, valid attributes: [replica]")
+
+	cfg = DefaultMetricsConfig().KymaReceiverLeader
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestKymaResourceConditionCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().KymaResourceConditionCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []KymaResourceConditionCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric kyma.resource.condition.count doesn't have an attribute invalid, valid attributes: [group, kind, namespace, status, type, version]")
+
+	cfg = DefaultMetricsConfig().KymaResourceConditionCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestKymaResourceCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().KymaResourceCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []KymaResourceCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric kyma.resource.count doesn't have an attribute invalid, valid attributes: [group, kind, namespace, state, version]")
+
+	cfg = DefaultMetricsConfig().KymaResourceCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestKymaResourceDeletionPendingMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().KymaResourceDeletionPending
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []KymaResourceDeletionPendingMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric kyma.resource.deletion.pending doesn't have an attribute invalid, valid attributes: [finalizers, group, kind, name, namespace, version]")
+
+	cfg = DefaultMetricsConfig().KymaResourceDeletionPending
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestKymaResourceScrapeErrorsMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().KymaResourceScrapeErrors
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []KymaResourceScrapeErrorsMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric kyma.resource.scrape.errors doesn't have an attribute invalid, valid attributes: [group, resource, version]")
+
+	cfg = DefaultMetricsConfig().KymaResourceScrapeErrors
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestKymaResourceStatusConditionLastTransitionMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().KymaResourceStatusConditionLastTransition
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []KymaResourceStatusConditionLastTransitionMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric kyma.resource.status.condition.last_transition doesn't have an attribute invalid, valid attributes: [group, kind, name, namespace, status, type, version]")
+
+	cfg = DefaultMetricsConfig().KymaResourceStatusConditionLastTransition
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestKymaResourceStatusConditionsMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().KymaResourceStatusConditions
 	require.NoError(t, cfg.Validate())
 
 	cfg.EnabledAttributes = []KymaResourceStatusConditionsMetricAttributeKey{"invalid"}
-	require.ErrorContains(t, cfg.Validate(), "metric kyma.resource.status.conditions doesn't have an attribute invalid, valid attributes: [group, kind, name, namespace, reason, status, type, version]")
+	require.ErrorContains(t, cfg.Validate(), "metric kyma.resource.status.conditions doesn't have an attribute invalid, valid attributes: [group, kind, message, name, namespace, reason, status, type, version]")
 
 	cfg = DefaultMetricsConfig().KymaResourceStatusConditions
 	cfg.AggregationStrategy = "invalid"
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestKymaResourceStatusGenerationLagMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().KymaResourceStatusGenerationLag
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []KymaResourceStatusGenerationLagMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric kyma.resource.status.generation_lag doesn't have an attribute invalid, valid attributes: [group, kind, name, namespace, version]")
+
+	cfg = DefaultMetricsConfig().KymaResourceStatusGenerationLag
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestKymaResourceStatusReconciledMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().KymaResourceStatusReconciled
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []KymaResourceStatusReconciledMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric kyma.resource.status.reconciled doesn't have an attribute invalid, valid attributes: [group, kind, name, namespace, version]")
+
+	cfg = DefaultMetricsConfig().KymaResourceStatusReconciled
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestKymaResourceStatusStateMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().KymaResourceStatusState
 	require.NoError(t, cfg.Validate())
@@ -125,21 +343,41 @@ func TestResourceAttributesConfig(t *testing.T) {
 		{
 			name: "all_set",
 			want: ResourceAttributesConfig{
-				K8sNamespaceName:   ResourceAttributeConfig{Enabled: true},
-				K8sResourceGroup:   ResourceAttributeConfig{Enabled: true},
-				K8sResourceKind:    ResourceAttributeConfig{Enabled: true},
-				K8sResourceName:    ResourceAttributeConfig{Enabled: true},
-				K8sResourceVersion: ResourceAttributeConfig{Enabled: true},
+				K8sClusterName:          ResourceAttributeConfig{Enabled: true},
+				K8sClusterUID:           ResourceAttributeConfig{Enabled: true},
+				K8sNamespaceName:        ResourceAttributeConfig{Enabled: true},
+				K8sResourceGroup:        ResourceAttributeConfig{Enabled: true},
+				K8sResourceKind:         ResourceAttributeConfig{Enabled: true},
+				K8sResourceName:         ResourceAttributeConfig{Enabled: true},
+				K8sResourceOwnerKind:    ResourceAttributeConfig{Enabled: true},
+				K8sResourceOwnerName:    ResourceAttributeConfig{Enabled: true},
+				K8sResourceOwnerUID:     ResourceAttributeConfig{Enabled: true},
+				K8sResourcePlural:       ResourceAttributeConfig{Enabled: true},
+				K8sResourceTopOwnerKind: ResourceAttributeConfig{Enabled: true},
+				K8sResourceTopOwnerName: ResourceAttributeConfig{Enabled: true},
+				K8sResourceTopOwnerUID:  ResourceAttributeConfig{Enabled: true},
+				K8sResourceVersion:      ResourceAttributeConfig{Enabled: true},
+				KymaReceiverShard:       ResourceAttributeConfig{Enabled: true},
 			},
 		},
 		{
 			name: "none_set",
 			want: ResourceAttributesConfig{
-				K8sNamespaceName:   ResourceAttributeConfig{Enabled: false},
-				K8sResourceGroup:   ResourceAttributeConfig{Enabled: false},
-				K8sResourceKind:    ResourceAttributeConfig{Enabled: false},
-				K8sResourceName:    ResourceAttributeConfig{Enabled: false},
-				K8sResourceVersion: ResourceAttributeConfig{Enabled: false},
+				K8sClusterName:          ResourceAttributeConfig{Enabled: false},
+				K8sClusterUID:           ResourceAttributeConfig{Enabled: false},
+				K8sNamespaceName:        ResourceAttributeConfig{Enabled: false},
+				K8sResourceGroup:        ResourceAttributeConfig{Enabled: false},
+				K8sResourceKind:         ResourceAttributeConfig{Enabled: false},
+				K8sResourceName:         ResourceAttributeConfig{Enabled: false},
+				K8sResourceOwnerKind:    ResourceAttributeConfig{Enabled: false},
+				K8sResourceOwnerName:    ResourceAttributeConfig{Enabled: false},
+				K8sResourceOwnerUID:     ResourceAttributeConfig{Enabled: false},
+				K8sResourcePlural:       ResourceAttributeConfig{Enabled: false},
+				K8sResourceTopOwnerKind: ResourceAttributeConfig{Enabled: false},
+				K8sResourceTopOwnerName: ResourceAttributeConfig{Enabled: false},
+				K8sResourceTopOwnerUID:  ResourceAttributeConfig{Enabled: false},
+				K8sResourceVersion:      ResourceAttributeConfig{Enabled: false},
+				KymaReceiverShard:       ResourceAttributeConfig{Enabled: false},
 			},
 		},
 	}
@@ -21,9 +21,45 @@ const (
 )
 
 var MetricsInfo = metricsInfo{
+	KymaModuleStatusState: metricInfo{
+		Name:       "kyma.module.status.state",
+		Attributes: []string{"channel", "fqdn", "group", "kind", "module", "module_version", "name", "namespace", "state", "version"},
+	},
+	KymaReceiverLeader: metricInfo{
+		Name:       "kyma.receiver.leader",
+		Attributes: []string{"replica"},
+	},
+	KymaResourceConditionCount: metricInfo{
+		Name:       "kyma.resource.condition.count",
+		Attributes: []string{"group", "kind", "namespace", "status", "type", "version"},
+	},
+	KymaResourceCount: metricInfo{
+		Name:       "kyma.resource.count",
+		Attributes: []string{"group", "kind", "namespace", "state", "version"},
+	},
+	KymaResourceDeletionPending: metricInfo{
+		Name:       "kyma.resource.deletion.pending",
+		Attributes: []string{"finalizers", "group", "kind", "name", "namespace", "version"},
+	},
+	KymaResourceScrapeErrors: metricInfo{
+		Name:       "kyma.resource.scrape.errors",
+		Attributes: []string{"group", "resource", "version"},
+	},
+	KymaResourceStatusConditionLastTransition: metricInfo{
+		Name:       "kyma.resource.status.condition.last_transition",
+		Attributes: []string{"group", "kind", "name", "namespace", "status", "type", "version"},
+	},
 	KymaResourceStatusConditions: metricInfo{
 		Name:       "kyma.resource.status.conditions",
-		Attributes: []string{"group", "kind", "name", "namespace", "reason", "status", "type", "version"},
+		Attributes: []string{"group", "kind", "message", "name", "namespace", "reason", "status", "type", "version"},
+	},
+	KymaResourceStatusGenerationLag: metricInfo{
+		Name:       "kyma.resource.status.generation_lag",
+		Attributes: []string{"group", "kind", "name", "namespace", "version"},
+	},
+	KymaResourceStatusReconciled: metricInfo{
+		Name:       "kyma.resource.status.reconciled",
+		Attributes: []string{"group", "kind", "name", "namespace", "version"},
 	},
 	KymaResourceStatusState: metricInfo{
 		Name:       "kyma.resource.status.state",
@@ -31,14 +67,739 @@ var MetricsInfo = metricsInfo{
 	},
 }
 
-type metricsInfo struct {
-	KymaResourceStatusConditions metricInfo
-	KymaResourceStatusState      metricInfo
+type metricsInfo struct {
+	KymaModuleStatusState                     metricInfo
+	KymaReceiverLeader                        metricInfo
+	KymaResourceConditionCount                metricInfo
+	KymaResourceCount                         metricInfo
+	KymaResourceDeletionPending               metricInfo
+	KymaResourceScrapeErrors                  metricInfo
+	KymaResourceStatusConditionLastTransition metricInfo
+	KymaResourceStatusConditions              metricInfo
+	KymaResourceStatusGenerationLag           metricInfo
+	KymaResourceStatusReconciled              metricInfo
+	KymaResourceStatusState                   metricInfo
+}
+
+type metricInfo struct {
+	Name       string
+	Attributes []string
+}
+
+type metricKymaModuleStatusState struct {
+	data          pmetric.Metric                    // data buffer for generated metric.
+	config        KymaModuleStatusStateMetricConfig // metric config provided by user.
+	capacity      int                               // max observed number of data points added to the metric.
+	aggDataPoints []int64                           // slice containing number of aggregated datapoints at each index
+}
+
+// init fills kyma.module.status.state metric with initial data.
+func (m *metricKymaModuleStatusState) init() {
+	m.data.SetName("kyma.module.status.state")
+	m.data.SetDescription("The state of a module listed in the status of a Kyma resource, metric value is 1 for the last scraped module state, including state as metric attribute.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricKymaModuleStatusState) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, channelAttributeValue string, fqdnAttributeValue string, groupAttributeValue string, kindAttributeValue string, moduleAttributeValue string, moduleVersionAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, stateAttributeValue string, versionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, KymaModuleStatusStateMetricAttributeKeyChannel) {
+		dp.Attributes().PutStr("channel", channelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaModuleStatusStateMetricAttributeKeyFqdn) {
+		dp.Attributes().PutStr("fqdn", fqdnAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaModuleStatusStateMetricAttributeKeyGroup) {
+		dp.Attributes().PutStr("group", groupAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaModuleStatusStateMetricAttributeKeyKind) {
+		dp.Attributes().PutStr("kind", kindAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaModuleStatusStateMetricAttributeKeyModule) {
+		dp.Attributes().PutStr("module", moduleAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaModuleStatusStateMetricAttributeKeyModuleVersion) {
+		dp.Attributes().PutStr("module_version", moduleVersionAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaModuleStatusStateMetricAttributeKeyName) {
+		dp.Attributes().PutStr("name", nameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaModuleStatusStateMetricAttributeKeyNamespace) {
+		dp.Attributes().PutStr("namespace", namespaceAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaModuleStatusStateMetricAttributeKeyState) {
+		dp.Attributes().PutStr("state", stateAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaModuleStatusStateMetricAttributeKeyVersion) {
+		dp.Attributes().PutStr("version", versionAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKymaModuleStatusState) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKymaModuleStatusState) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKymaModuleStatusState(cfg KymaModuleStatusStateMetricConfig) metricKymaModuleStatusState {
+	m := metricKymaModuleStatusState{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricKymaReceiverLeader struct {
+	data          pmetric.Metric                 // data buffer for generated metric.
+	config        KymaReceiverLeaderMetricConfig // metric config provided by user.
+	capacity      int                            // max observed number of data points added to the metric.
+	aggDataPoints []int64                        // slice containing number of aggregated datapoints at each index
+}
+
+// init fills kyma.receiver.leader metric with initial data.
+func (m *metricKymaReceiverLeader) init() {
+	m.data.SetName("kyma.receiver.leader")
+	m.data.SetDescription("Whether the receiver replica scrapes resources, 1 if it holds the leadership or no leader elector is configured, 0 otherwise.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricKymaReceiverLeader) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, replicaAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, KymaReceiverLeaderMetricAttributeKeyReplica) {
+		dp.Attributes().PutStr("replica", replicaAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKymaReceiverLeader) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKymaReceiverLeader) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKymaReceiverLeader(cfg KymaReceiverLeaderMetricConfig) metricKymaReceiverLeader {
+	m := metricKymaReceiverLeader{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricKymaResourceConditionCount struct {
+	data          pmetric.Metric                         // data buffer for generated metric.
+	config        KymaResourceConditionCountMetricConfig // metric config provided by user.
+	capacity      int                                    // max observed number of data points added to the metric.
+	aggDataPoints []int64                                // slice containing number of aggregated datapoints at each index
+}
+
+// init fills kyma.resource.condition.count metric with initial data.
+func (m *metricKymaResourceConditionCount) init() {
+	m.data.SetName("kyma.resource.condition.count")
+	m.data.SetDescription("The number of resources per condition type and status, aggregated by group, version, kind, and namespace.")
+	m.data.SetUnit("{resource}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricKymaResourceConditionCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, namespaceAttributeValue string, statusAttributeValue string, typeAttributeValue string, versionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceConditionCountMetricAttributeKeyGroup) {
+		dp.Attributes().PutStr("group", groupAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceConditionCountMetricAttributeKeyKind) {
+		dp.Attributes().PutStr("kind", kindAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceConditionCountMetricAttributeKeyNamespace) {
+		dp.Attributes().PutStr("namespace", namespaceAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceConditionCountMetricAttributeKeyStatus) {
+		dp.Attributes().PutStr("status", statusAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceConditionCountMetricAttributeKeyType) {
+		dp.Attributes().PutStr("type", typeAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceConditionCountMetricAttributeKeyVersion) {
+		dp.Attributes().PutStr("version", versionAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKymaResourceConditionCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKymaResourceConditionCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKymaResourceConditionCount(cfg KymaResourceConditionCountMetricConfig) metricKymaResourceConditionCount {
+	m := metricKymaResourceConditionCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricKymaResourceCount struct {
+	data          pmetric.Metric                // data buffer for generated metric.
+	config        KymaResourceCountMetricConfig // metric config provided by user.
+	capacity      int                           // max observed number of data points added to the metric.
+	aggDataPoints []int64                       // slice containing number of aggregated datapoints at each index
+}
+
+// init fills kyma.resource.count metric with initial data.
+func (m *metricKymaResourceCount) init() {
+	m.data.SetName("kyma.resource.count")
+	m.data.SetDescription("The number of resources per state, aggregated by group, version, kind, and namespace. Resources without a state are counted with an empty state.")
+	m.data.SetUnit("{resource}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricKymaResourceCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, namespaceAttributeValue string, stateAttributeValue string, versionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceCountMetricAttributeKeyGroup) {
+		dp.Attributes().PutStr("group", groupAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceCountMetricAttributeKeyKind) {
+		dp.Attributes().PutStr("kind", kindAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceCountMetricAttributeKeyNamespace) {
+		dp.Attributes().PutStr("namespace", namespaceAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceCountMetricAttributeKeyState) {
+		dp.Attributes().PutStr("state", stateAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceCountMetricAttributeKeyVersion) {
+		dp.Attributes().PutStr("version", versionAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKymaResourceCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKymaResourceCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKymaResourceCount(cfg KymaResourceCountMetricConfig) metricKymaResourceCount {
+	m := metricKymaResourceCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricKymaResourceDeletionPending struct {
+	data          pmetric.Metric                          // data buffer for generated metric.
+	config        KymaResourceDeletionPendingMetricConfig // metric config provided by user.
+	capacity      int                                     // max observed number of data points added to the metric.
+	aggDataPoints []int64                                 // slice containing number of aggregated datapoints at each index
+}
+
+// init fills kyma.resource.deletion.pending metric with initial data.
+func (m *metricKymaResourceDeletionPending) init() {
+	m.data.SetName("kyma.resource.deletion.pending")
+	m.data.SetDescription("The time since the deletion of the resource was requested, in seconds. Only reported for resources with a deletionTimestamp, which are usually blocked by the listed finalizers.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricKymaResourceDeletionPending) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, finalizersAttributeValue string, groupAttributeValue string, kindAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, versionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceDeletionPendingMetricAttributeKeyFinalizers) {
+		dp.Attributes().PutStr("finalizers", finalizersAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceDeletionPendingMetricAttributeKeyGroup) {
+		dp.Attributes().PutStr("group", groupAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceDeletionPendingMetricAttributeKeyKind) {
+		dp.Attributes().PutStr("kind", kindAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceDeletionPendingMetricAttributeKeyName) {
+		dp.Attributes().PutStr("name", nameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceDeletionPendingMetricAttributeKeyNamespace) {
+		dp.Attributes().PutStr("namespace", namespaceAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceDeletionPendingMetricAttributeKeyVersion) {
+		dp.Attributes().PutStr("version", versionAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKymaResourceDeletionPending) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKymaResourceDeletionPending) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKymaResourceDeletionPending(cfg KymaResourceDeletionPendingMetricConfig) metricKymaResourceDeletionPending {
+	m := metricKymaResourceDeletionPending{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricKymaResourceScrapeErrors struct {
+	data          pmetric.Metric                       // data buffer for generated metric.
+	config        KymaResourceScrapeErrorsMetricConfig // metric config provided by user.
+	capacity      int                                  // max observed number of data points added to the metric.
+	aggDataPoints []int64                              // slice containing number of aggregated datapoints at each index
+}
+
+// init fills kyma.resource.scrape.errors metric with initial data.
+func (m *metricKymaResourceScrapeErrors) init() {
+	m.data.SetName("kyma.resource.scrape.errors")
+	m.data.SetDescription("The number of failed list requests for the resource in the last scrape. A value greater than 0 indicates that the resource isn't collected, for example because the CRD is not installed or RBAC permissions are missing.")
+	m.data.SetUnit("{error}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricKymaResourceScrapeErrors) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, groupAttributeValue string, resourceAttributeValue string, versionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceScrapeErrorsMetricAttributeKeyGroup) {
+		dp.Attributes().PutStr("group", groupAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceScrapeErrorsMetricAttributeKeyResource) {
+		dp.Attributes().PutStr("resource", resourceAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceScrapeErrorsMetricAttributeKeyVersion) {
+		dp.Attributes().PutStr("version", versionAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKymaResourceScrapeErrors) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKymaResourceScrapeErrors) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKymaResourceScrapeErrors(cfg KymaResourceScrapeErrorsMetricConfig) metricKymaResourceScrapeErrors {
+	m := metricKymaResourceScrapeErrors{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricKymaResourceStatusConditionLastTransition struct {
+	data          pmetric.Metric                                        // data buffer for generated metric.
+	config        KymaResourceStatusConditionLastTransitionMetricConfig // metric config provided by user.
+	capacity      int                                                   // max observed number of data points added to the metric.
+	aggDataPoints []int64                                               // slice containing number of aggregated datapoints at each index
+}
+
+// init fills kyma.resource.status.condition.last_transition metric with initial data.
+func (m *metricKymaResourceStatusConditionLastTransition) init() {
+	m.data.SetName("kyma.resource.status.condition.last_transition")
+	m.data.SetDescription("The time of the last transition of the resource status condition, as seconds since the Unix epoch. Only reported for conditions with a lastTransitionTime.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricKymaResourceStatusConditionLastTransition) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, statusAttributeValue string, typeAttributeValue string, versionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusConditionLastTransitionMetricAttributeKeyGroup) {
+		dp.Attributes().PutStr("group", groupAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusConditionLastTransitionMetricAttributeKeyKind) {
+		dp.Attributes().PutStr("kind", kindAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusConditionLastTransitionMetricAttributeKeyName) {
+		dp.Attributes().PutStr("name", nameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusConditionLastTransitionMetricAttributeKeyNamespace) {
+		dp.Attributes().PutStr("namespace", namespaceAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusConditionLastTransitionMetricAttributeKeyStatus) {
+		dp.Attributes().PutStr("status", statusAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusConditionLastTransitionMetricAttributeKeyType) {
+		dp.Attributes().PutStr("type", typeAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusConditionLastTransitionMetricAttributeKeyVersion) {
+		dp.Attributes().PutStr("version", versionAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKymaResourceStatusConditionLastTransition) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
 }
 
-type metricInfo struct {
-	Name       string
-	Attributes []string
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKymaResourceStatusConditionLastTransition) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKymaResourceStatusConditionLastTransition(cfg KymaResourceStatusConditionLastTransitionMetricConfig) metricKymaResourceStatusConditionLastTransition {
+	m := metricKymaResourceStatusConditionLastTransition{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
 }
 
 type metricKymaResourceStatusConditions struct {
@@ -58,7 +819,7 @@ func (m *metricKymaResourceStatusConditions) init() {
 	m.aggDataPoints = m.aggDataPoints[:0]
 }
 
-func (m *metricKymaResourceStatusConditions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, reasonAttributeValue string, statusAttributeValue string, typeAttributeValue string, versionAttributeValue string) {
+func (m *metricKymaResourceStatusConditions) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, messageAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, reasonAttributeValue string, statusAttributeValue string, typeAttributeValue string, versionAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -72,6 +833,9 @@ func (m *metricKymaResourceStatusConditions) recordDataPoint(start pcommon.Times
 	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusConditionsMetricAttributeKeyKind) {
 		dp.Attributes().PutStr("kind", kindAttributeValue)
 	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusConditionsMetricAttributeKeyMessage) {
+		dp.Attributes().PutStr("message", messageAttributeValue)
+	}
 	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusConditionsMetricAttributeKeyName) {
 		dp.Attributes().PutStr("name", nameAttributeValue)
 	}
@@ -151,6 +915,208 @@ func newMetricKymaResourceStatusConditions(cfg KymaResourceStatusConditionsMetri
 	return m
 }
 
+type metricKymaResourceStatusGenerationLag struct {
+	data          pmetric.Metric                              // data buffer for generated metric.
+	config        KymaResourceStatusGenerationLagMetricConfig // metric config provided by user.
+	capacity      int                                         // max observed number of data points added to the metric.
+	aggDataPoints []int64                                     // slice containing number of aggregated datapoints at each index
+}
+
+// init fills kyma.resource.status.generation_lag metric with initial data.
+func (m *metricKymaResourceStatusGenerationLag) init() {
+	m.data.SetName("kyma.resource.status.generation_lag")
+	m.data.SetDescription("The difference between the resource metadata.generation and status.observedGeneration. A lag that doesn't go back to 0 indicates that the controller doesn't pick up spec changes. Only reported for resources with a status.observedGeneration.")
+	m.data.SetUnit("{generation}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricKymaResourceStatusGenerationLag) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, versionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusGenerationLagMetricAttributeKeyGroup) {
+		dp.Attributes().PutStr("group", groupAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusGenerationLagMetricAttributeKeyKind) {
+		dp.Attributes().PutStr("kind", kindAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusGenerationLagMetricAttributeKeyName) {
+		dp.Attributes().PutStr("name", nameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusGenerationLagMetricAttributeKeyNamespace) {
+		dp.Attributes().PutStr("namespace", namespaceAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusGenerationLagMetricAttributeKeyVersion) {
+		dp.Attributes().PutStr("version", versionAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKymaResourceStatusGenerationLag) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKymaResourceStatusGenerationLag) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKymaResourceStatusGenerationLag(cfg KymaResourceStatusGenerationLagMetricConfig) metricKymaResourceStatusGenerationLag {
+	m := metricKymaResourceStatusGenerationLag{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricKymaResourceStatusReconciled struct {
+	data          pmetric.Metric                           // data buffer for generated metric.
+	config        KymaResourceStatusReconciledMetricConfig // metric config provided by user.
+	capacity      int                                      // max observed number of data points added to the metric.
+	aggDataPoints []int64                                  // slice containing number of aggregated datapoints at each index
+}
+
+// init fills kyma.resource.status.reconciled metric with initial data.
+func (m *metricKymaResourceStatusReconciled) init() {
+	m.data.SetName("kyma.resource.status.reconciled")
+	m.data.SetDescription("Whether the resource status.observedGeneration matches the metadata.generation. The metric value is 1 if the latest spec was reconciled, and 0 otherwise. Only reported for resources with a status.observedGeneration.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricKymaResourceStatusReconciled) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, versionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusReconciledMetricAttributeKeyGroup) {
+		dp.Attributes().PutStr("group", groupAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusReconciledMetricAttributeKeyKind) {
+		dp.Attributes().PutStr("kind", kindAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusReconciledMetricAttributeKeyName) {
+		dp.Attributes().PutStr("name", nameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusReconciledMetricAttributeKeyNamespace) {
+		dp.Attributes().PutStr("namespace", namespaceAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, KymaResourceStatusReconciledMetricAttributeKeyVersion) {
+		dp.Attributes().PutStr("version", versionAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricKymaResourceStatusReconciled) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricKymaResourceStatusReconciled) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricKymaResourceStatusReconciled(cfg KymaResourceStatusReconciledMetricConfig) metricKymaResourceStatusReconciled {
+	m := metricKymaResourceStatusReconciled{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricKymaResourceStatusState struct {
 	data          pmetric.Metric                      // data buffer for generated metric.
 	config        KymaResourceStatusStateMetricConfig // metric config provided by user.
@@ -161,7 +1127,7 @@ type metricKymaResourceStatusState struct {
 // init fills kyma.resource.status.state metric with initial data.
 func (m *metricKymaResourceStatusState) init() {
 	m.data.SetName("kyma.resource.status.state")
-	m.data.SetDescription("The resource status state, metric value is 1 for the last scraped resource status state, including state as metric attribute.")
+	m.data.SetDescription("The resource status state, metric value is 1 for the last scraped resource status state, including state as metric attribute. If state_set is enabled, every other known state is reported with value 0.")
 	m.data.SetUnit("1")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
@@ -258,15 +1224,24 @@ func newMetricKymaResourceStatusState(cfg KymaResourceStatusStateMetricConfig) m
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                             MetricsBuilderConfig // config of the metrics builder.
-	startTime                          pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                    int                  // maximum observed number of metrics per resource.
-	metricsBuffer                      pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                          component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter     map[string]filter.Filter
-	resourceAttributeExcludeFilter     map[string]filter.Filter
-	metricKymaResourceStatusConditions metricKymaResourceStatusConditions
-	metricKymaResourceStatusState      metricKymaResourceStatusState
+	config                                          MetricsBuilderConfig // config of the metrics builder.
+	startTime                                       pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                                 int                  // maximum observed number of metrics per resource.
+	metricsBuffer                                   pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                       component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter                  map[string]filter.Filter
+	resourceAttributeExcludeFilter                  map[string]filter.Filter
+	metricKymaModuleStatusState                     metricKymaModuleStatusState
+	metricKymaReceiverLeader                        metricKymaReceiverLeader
+	metricKymaResourceConditionCount                metricKymaResourceConditionCount
+	metricKymaResourceCount                         metricKymaResourceCount
+	metricKymaResourceDeletionPending               metricKymaResourceDeletionPending
+	metricKymaResourceScrapeErrors                  metricKymaResourceScrapeErrors
+	metricKymaResourceStatusConditionLastTransition metricKymaResourceStatusConditionLastTransition
+	metricKymaResourceStatusConditions              metricKymaResourceStatusConditions
+	metricKymaResourceStatusGenerationLag           metricKymaResourceStatusGenerationLag
+	metricKymaResourceStatusReconciled              metricKymaResourceStatusReconciled
+	metricKymaResourceStatusState                   metricKymaResourceStatusState
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -288,14 +1263,35 @@ func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
 }
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                             mbc,
-		startTime:                          pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                      pmetric.NewMetrics(),
-		buildInfo:                          settings.BuildInfo,
-		metricKymaResourceStatusConditions: newMetricKymaResourceStatusConditions(mbc.Metrics.KymaResourceStatusConditions),
-		metricKymaResourceStatusState:      newMetricKymaResourceStatusState(mbc.Metrics.KymaResourceStatusState),
-		resourceAttributeIncludeFilter:     make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:     make(map[string]filter.Filter),
+		config:                            mbc,
+		startTime:                         pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                     pmetric.NewMetrics(),
+		buildInfo:                         settings.BuildInfo,
+		metricKymaModuleStatusState:       newMetricKymaModuleStatusState(mbc.Metrics.KymaModuleStatusState),
+		metricKymaReceiverLeader:          newMetricKymaReceiverLeader(mbc.Metrics.KymaReceiverLeader),
+		metricKymaResourceConditionCount:  newMetricKymaResourceConditionCount(mbc.Metrics.KymaResourceConditionCount),
+		metricKymaResourceCount:           newMetricKymaResourceCount(mbc.Metrics.KymaResourceCount),
+		metricKymaResourceDeletionPending: newMetricKymaResourceDeletionPending(mbc.Metrics.KymaResourceDeletionPending),
+		metricKymaResourceScrapeErrors:    newMetricKymaResourceScrapeErrors(mbc.Metrics.KymaResourceScrapeErrors),
+		metricKymaResourceStatusConditionLastTransition: newMetricKymaResourceStatusConditionLastTransition(mbc.Metrics.KymaResourceStatusConditionLastTransition),
+		metricKymaResourceStatusConditions:              newMetricKymaResourceStatusConditions(mbc.Metrics.KymaResourceStatusConditions),
+		metricKymaResourceStatusGenerationLag:           newMetricKymaResourceStatusGenerationLag(mbc.Metrics.KymaResourceStatusGenerationLag),
+		metricKymaResourceStatusReconciled:              newMetricKymaResourceStatusReconciled(mbc.Metrics.KymaResourceStatusReconciled),
+		metricKymaResourceStatusState:                   newMetricKymaResourceStatusState(mbc.Metrics.KymaResourceStatusState),
+		resourceAttributeIncludeFilter:                  make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:                  make(map[string]filter.Filter),
+	}
+	if mbc.ResourceAttributes.K8sClusterName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.cluster.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sClusterName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sClusterName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.cluster.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sClusterName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sClusterUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.cluster.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sClusterUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sClusterUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.cluster.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sClusterUID.MetricsExclude)
 	}
 	if mbc.ResourceAttributes.K8sNamespaceName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["k8s.namespace.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sNamespaceName.MetricsInclude)
@@ -321,12 +1317,60 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 	if mbc.ResourceAttributes.K8sResourceName.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["k8s.resource.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceName.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.K8sResourceOwnerKind.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.resource.owner.kind"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceOwnerKind.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceOwnerKind.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.resource.owner.kind"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceOwnerKind.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceOwnerName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.resource.owner.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceOwnerName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceOwnerName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.resource.owner.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceOwnerName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceOwnerUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.resource.owner.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceOwnerUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceOwnerUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.resource.owner.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceOwnerUID.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sResourcePlural.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.resource.plural"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourcePlural.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sResourcePlural.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.resource.plural"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourcePlural.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceTopOwnerKind.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.resource.top_owner.kind"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceTopOwnerKind.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceTopOwnerKind.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.resource.top_owner.kind"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceTopOwnerKind.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceTopOwnerName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.resource.top_owner.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceTopOwnerName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceTopOwnerName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.resource.top_owner.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceTopOwnerName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceTopOwnerUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.resource.top_owner.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceTopOwnerUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sResourceTopOwnerUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.resource.top_owner.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceTopOwnerUID.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.K8sResourceVersion.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["k8s.resource.version"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceVersion.MetricsInclude)
 	}
 	if mbc.ResourceAttributes.K8sResourceVersion.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["k8s.resource.version"] = filter.CreateFilter(mbc.ResourceAttributes.K8sResourceVersion.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.KymaReceiverShard.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["kyma.receiver.shard"] = filter.CreateFilter(mbc.ResourceAttributes.KymaReceiverShard.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.KymaReceiverShard.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["kyma.receiver.shard"] = filter.CreateFilter(mbc.ResourceAttributes.KymaReceiverShard.MetricsExclude)
+	}
 
 	for _, op := range options {
 		op.apply(mb)
@@ -396,7 +1440,16 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	ils.Scope().SetName(ScopeName)
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricKymaModuleStatusState.emit(ils.Metrics())
+	mb.metricKymaReceiverLeader.emit(ils.Metrics())
+	mb.metricKymaResourceConditionCount.emit(ils.Metrics())
+	mb.metricKymaResourceCount.emit(ils.Metrics())
+	mb.metricKymaResourceDeletionPending.emit(ils.Metrics())
+	mb.metricKymaResourceScrapeErrors.emit(ils.Metrics())
+	mb.metricKymaResourceStatusConditionLastTransition.emit(ils.Metrics())
 	mb.metricKymaResourceStatusConditions.emit(ils.Metrics())
+	mb.metricKymaResourceStatusGenerationLag.emit(ils.Metrics())
+	mb.metricKymaResourceStatusReconciled.emit(ils.Metrics())
 	mb.metricKymaResourceStatusState.emit(ils.Metrics())
 
 	for _, op := range options {
@@ -429,9 +1482,54 @@ func (mb *MetricsBuilder) Emit(options ...ResourceMetricsOption) pmetric.Metrics
 	return metrics
 }
 
+// RecordKymaModuleStatusStateDataPoint adds a data point to kyma.module.status.state metric.
+func (mb *MetricsBuilder) RecordKymaModuleStatusStateDataPoint(ts pcommon.Timestamp, val int64, channelAttributeValue string, fqdnAttributeValue string, groupAttributeValue string, kindAttributeValue string, moduleAttributeValue string, moduleVersionAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, stateAttributeValue string, versionAttributeValue string) {
+	mb.metricKymaModuleStatusState.recordDataPoint(mb.startTime, ts, val, channelAttributeValue, fqdnAttributeValue, groupAttributeValue, kindAttributeValue, moduleAttributeValue, moduleVersionAttributeValue, nameAttributeValue, namespaceAttributeValue, stateAttributeValue, versionAttributeValue)
+}
+
+// RecordKymaReceiverLeaderDataPoint adds a data point to kyma.receiver.leader metric.
+func (mb *MetricsBuilder) RecordKymaReceiverLeaderDataPoint(ts pcommon.Timestamp, val int64, replicaAttributeValue string) {
+	mb.metricKymaReceiverLeader.recordDataPoint(mb.startTime, ts, val, replicaAttributeValue)
+}
+
+// RecordKymaResourceConditionCountDataPoint adds a data point to kyma.resource.condition.count metric.
+func (mb *MetricsBuilder) RecordKymaResourceConditionCountDataPoint(ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, namespaceAttributeValue string, statusAttributeValue string, typeAttributeValue string, versionAttributeValue string) {
+	mb.metricKymaResourceConditionCount.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, kindAttributeValue, namespaceAttributeValue, statusAttributeValue, typeAttributeValue, versionAttributeValue)
+}
+
+// RecordKymaResourceCountDataPoint adds a data point to kyma.resource.count metric.
+func (mb *MetricsBuilder) RecordKymaResourceCountDataPoint(ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, namespaceAttributeValue string, stateAttributeValue string, versionAttributeValue string) {
+	mb.metricKymaResourceCount.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, kindAttributeValue, namespaceAttributeValue, stateAttributeValue, versionAttributeValue)
+}
+
+// RecordKymaResourceDeletionPendingDataPoint adds a data point to kyma.resource.deletion.pending metric.
+func (mb *MetricsBuilder) RecordKymaResourceDeletionPendingDataPoint(ts pcommon.Timestamp, val int64, finalizersAttributeValue string, groupAttributeValue string, kindAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, versionAttributeValue string) {
+	mb.metricKymaResourceDeletionPending.recordDataPoint(mb.startTime, ts, val, finalizersAttributeValue, groupAttributeValue, kindAttributeValue, nameAttributeValue, namespaceAttributeValue, versionAttributeValue)
+}
+
+// RecordKymaResourceScrapeErrorsDataPoint adds a data point to kyma.resource.scrape.errors metric.
+func (mb *MetricsBuilder) RecordKymaResourceScrapeErrorsDataPoint(ts pcommon.Timestamp, val int64, groupAttributeValue string, resourceAttributeValue string, versionAttributeValue string) {
+	mb.metricKymaResourceScrapeErrors.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, resourceAttributeValue, versionAttributeValue)
+}
+
+// RecordKymaResourceStatusConditionLastTransitionDataPoint adds a data point to kyma.resource.status.condition.last_transition metric.
+func (mb *MetricsBuilder) RecordKymaResourceStatusConditionLastTransitionDataPoint(ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, statusAttributeValue string, typeAttributeValue string, versionAttributeValue string) {
+	mb.metricKymaResourceStatusConditionLastTransition.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, kindAttributeValue, nameAttributeValue, namespaceAttributeValue, statusAttributeValue, typeAttributeValue, versionAttributeValue)
+}
+
 // RecordKymaResourceStatusConditionsDataPoint adds a data point to kyma.resource.status.conditions metric.
-func (mb *MetricsBuilder) RecordKymaResourceStatusConditionsDataPoint(ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, reasonAttributeValue string, statusAttributeValue string, typeAttributeValue string, versionAttributeValue string) {
-	mb.metricKymaResourceStatusConditions.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, kindAttributeValue, nameAttributeValue, namespaceAttributeValue, reasonAttributeValue, statusAttributeValue, typeAttributeValue, versionAttributeValue)
+func (mb *MetricsBuilder) RecordKymaResourceStatusConditionsDataPoint(ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, messageAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, reasonAttributeValue string, statusAttributeValue string, typeAttributeValue string, versionAttributeValue string) {
+	mb.metricKymaResourceStatusConditions.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, kindAttributeValue, messageAttributeValue, nameAttributeValue, namespaceAttributeValue, reasonAttributeValue, statusAttributeValue, typeAttributeValue, versionAttributeValue)
+}
+
+// RecordKymaResourceStatusGenerationLagDataPoint adds a data point to kyma.resource.status.generation_lag metric.
+func (mb *MetricsBuilder) RecordKymaResourceStatusGenerationLagDataPoint(ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, versionAttributeValue string) {
+	mb.metricKymaResourceStatusGenerationLag.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, kindAttributeValue, nameAttributeValue, namespaceAttributeValue, versionAttributeValue)
+}
+
+// RecordKymaResourceStatusReconciledDataPoint adds a data point to kyma.resource.status.reconciled metric.
+func (mb *MetricsBuilder) RecordKymaResourceStatusReconciledDataPoint(ts pcommon.Timestamp, val int64, groupAttributeValue string, kindAttributeValue string, nameAttributeValue string, namespaceAttributeValue string, versionAttributeValue string) {
+	mb.metricKymaResourceStatusReconciled.recordDataPoint(mb.startTime, ts, val, groupAttributeValue, kindAttributeValue, nameAttributeValue, namespaceAttributeValue, versionAttributeValue)
 }
 
 // RecordKymaResourceStatusStateDataPoint adds a data point to kyma.resource.status.state metric.
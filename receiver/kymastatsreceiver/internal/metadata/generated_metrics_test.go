@@ -67,7 +67,16 @@ func TestMetricsBuilder(t *testing.T) {
 			settings.Logger = zap.New(observedZapCore)
 			mb := NewMetricsBuilder(loadMetricsBuilderConfig(t, tt.name), settings, WithStartTime(start))
 			aggMap := make(map[string]string) // contains the aggregation strategies for each metric name
+			aggMap["kyma.module.status.state"] = mb.metricKymaModuleStatusState.config.AggregationStrategy
+			aggMap["kyma.receiver.leader"] = mb.metricKymaReceiverLeader.config.AggregationStrategy
+			aggMap["kyma.resource.condition.count"] = mb.metricKymaResourceConditionCount.config.AggregationStrategy
+			aggMap["kyma.resource.count"] = mb.metricKymaResourceCount.config.AggregationStrategy
+			aggMap["kyma.resource.deletion.pending"] = mb.metricKymaResourceDeletionPending.config.AggregationStrategy
+			aggMap["kyma.resource.scrape.errors"] = mb.metricKymaResourceScrapeErrors.config.AggregationStrategy
+			aggMap["kyma.resource.status.condition.last_transition"] = mb.metricKymaResourceStatusConditionLastTransition.config.AggregationStrategy
 			aggMap["kyma.resource.status.conditions"] = mb.metricKymaResourceStatusConditions.config.AggregationStrategy
+			aggMap["kyma.resource.status.generation_lag"] = mb.metricKymaResourceStatusGenerationLag.config.AggregationStrategy
+			aggMap["kyma.resource.status.reconciled"] = mb.metricKymaResourceStatusReconciled.config.AggregationStrategy
 			aggMap["kyma.resource.status.state"] = mb.metricKymaResourceStatusState.config.AggregationStrategy
 
 			expectedWarnings := 0
@@ -79,9 +88,58 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount := 0
 			defaultMetricsCount++
 			allMetricsCount++
-			mb.RecordKymaResourceStatusConditionsDataPoint(ts, 1, "group-val", "kind-val", "name-val", "namespace-val", "reason-val", "status-val", "type-val", "version-val")
+			mb.RecordKymaModuleStatusStateDataPoint(ts, 1, "channel-val", "fqdn-val", "group-val", "kind-val", "module-val", "module_version-val", "name-val", "namespace-val", "state-val", "version-val")
 			if tt.name == "reaggregate_set" {
-				mb.RecordKymaResourceStatusConditionsDataPoint(ts, 3, "group-val-2", "kind-val-2", "name-val-2", "namespace-val-2", "reason-val-2", "status-val-2", "type-val-2", "version-val-2")
+				mb.RecordKymaModuleStatusStateDataPoint(ts, 3, "channel-val-2", "fqdn-val-2", "group-val-2", "kind-val-2", "module-val-2", "module_version-val-2", "name-val-2", "namespace-val-2", "state-val-2", "version-val-2")
+			}
+			allMetricsCount++
+			mb.RecordKymaReceiverLeaderDataPoint(ts, 1, "replica-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordKymaReceiverLeaderDataPoint(ts, 3, "replica-val-2")
+			}
+			allMetricsCount++
+			mb.RecordKymaResourceConditionCountDataPoint(ts, 1, "group-val", "kind-val", "namespace-val", "status-val", "type-val", "version-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordKymaResourceConditionCountDataPoint(ts, 3, "group-val-2", "kind-val-2", "namespace-val-2", "status-val-2", "type-val-2", "version-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordKymaResourceCountDataPoint(ts, 1, "group-val", "kind-val", "namespace-val", "state-val", "version-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordKymaResourceCountDataPoint(ts, 3, "group-val-2", "kind-val-2", "namespace-val-2", "state-val-2", "version-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordKymaResourceDeletionPendingDataPoint(ts, 1, "finalizers-val", "group-val", "kind-val", "name-val", "namespace-val", "version-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordKymaResourceDeletionPendingDataPoint(ts, 3, "finalizers-val-2", "group-val-2", "kind-val-2", "name-val-2", "namespace-val-2", "version-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordKymaResourceScrapeErrorsDataPoint(ts, 1, "group-val", "resource-val", "version-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordKymaResourceScrapeErrorsDataPoint(ts, 3, "group-val-2", "resource-val-2", "version-val-2")
+			}
+			allMetricsCount++
+			mb.RecordKymaResourceStatusConditionLastTransitionDataPoint(ts, 1, "group-val", "kind-val", "name-val", "namespace-val", "status-val", "type-val", "version-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordKymaResourceStatusConditionLastTransitionDataPoint(ts, 3, "group-val-2", "kind-val-2", "name-val-2", "namespace-val-2", "status-val-2", "type-val-2", "version-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordKymaResourceStatusConditionsDataPoint(ts, 1, "group-val", "kind-val", "message-val", "name-val", "namespace-val", "reason-val", "status-val", "type-val", "version-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordKymaResourceStatusConditionsDataPoint(ts, 3, "group-val-2", "kind-val-2", "message-val-2", "name-val-2", "namespace-val-2", "reason-val-2", "status-val-2", "type-val-2", "version-val-2")
+			}
+			allMetricsCount++
+			mb.RecordKymaResourceStatusGenerationLagDataPoint(ts, 1, "group-val", "kind-val", "name-val", "namespace-val", "version-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordKymaResourceStatusGenerationLagDataPoint(ts, 3, "group-val-2", "kind-val-2", "name-val-2", "namespace-val-2", "version-val-2")
+			}
+			allMetricsCount++
+			mb.RecordKymaResourceStatusReconciledDataPoint(ts, 1, "group-val", "kind-val", "name-val", "namespace-val", "version-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordKymaResourceStatusReconciledDataPoint(ts, 3, "group-val-2", "kind-val-2", "name-val-2", "namespace-val-2", "version-val-2")
 			}
 			defaultMetricsCount++
 			allMetricsCount++
@@ -91,15 +149,34 @@ func TestMetricsBuilder(t *testing.T) {
 			}
 
 			rb := mb.NewResourceBuilder()
+			rb.SetK8sClusterName("k8s.cluster.name-val")
+			rb.SetK8sClusterUID("k8s.cluster.uid-val")
 			rb.SetK8sNamespaceName("k8s.namespace.name-val")
 			rb.SetK8sResourceGroup("k8s.resource.group-val")
 			rb.SetK8sResourceKind("k8s.resource.kind-val")
 			rb.SetK8sResourceName("k8s.resource.name-val")
+			rb.SetK8sResourceOwnerKind("k8s.resource.owner.kind-val")
+			rb.SetK8sResourceOwnerName("k8s.resource.owner.name-val")
+			rb.SetK8sResourceOwnerUID("k8s.resource.owner.uid-val")
+			rb.SetK8sResourcePlural("k8s.resource.plural-val")
+			rb.SetK8sResourceTopOwnerKind("k8s.resource.top_owner.kind-val")
+			rb.SetK8sResourceTopOwnerName("k8s.resource.top_owner.name-val")
+			rb.SetK8sResourceTopOwnerUID("k8s.resource.top_owner.uid-val")
 			rb.SetK8sResourceVersion("k8s.resource.version-val")
+			rb.SetKymaReceiverShard("kyma.receiver.shard-val")
 			res := rb.Emit()
 			metrics := mb.Emit(WithResource(res))
 			if tt.name == "reaggregate_set" {
+				assert.Empty(t, mb.metricKymaModuleStatusState.aggDataPoints)
+				assert.Empty(t, mb.metricKymaReceiverLeader.aggDataPoints)
+				assert.Empty(t, mb.metricKymaResourceConditionCount.aggDataPoints)
+				assert.Empty(t, mb.metricKymaResourceCount.aggDataPoints)
+				assert.Empty(t, mb.metricKymaResourceDeletionPending.aggDataPoints)
+				assert.Empty(t, mb.metricKymaResourceScrapeErrors.aggDataPoints)
+				assert.Empty(t, mb.metricKymaResourceStatusConditionLastTransition.aggDataPoints)
 				assert.Empty(t, mb.metricKymaResourceStatusConditions.aggDataPoints)
+				assert.Empty(t, mb.metricKymaResourceStatusGenerationLag.aggDataPoints)
+				assert.Empty(t, mb.metricKymaResourceStatusReconciled.aggDataPoints)
 				assert.Empty(t, mb.metricKymaResourceStatusState.aggDataPoints)
 			}
 
@@ -128,6 +205,441 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for _, mi := range allMetricsList {
 				switch mi.Name() {
+				case "kyma.module.status.state":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["kyma.module.status.state"], "Found a duplicate in the metrics slice: kyma.module.status.state")
+						validatedMetrics["kyma.module.status.state"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The state of a module listed in the status of a Kyma resource, metric value is 1 for the last scraped module state, including state as metric attribute.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						channelAttrVal, ok := dp.Attributes().Get("channel")
+						assert.True(t, ok)
+						assert.Equal(t, "channel-val", channelAttrVal.Str())
+						fqdnAttrVal, ok := dp.Attributes().Get("fqdn")
+						assert.True(t, ok)
+						assert.Equal(t, "fqdn-val", fqdnAttrVal.Str())
+						groupAttrVal, ok := dp.Attributes().Get("group")
+						assert.True(t, ok)
+						assert.Equal(t, "group-val", groupAttrVal.Str())
+						kindAttrVal, ok := dp.Attributes().Get("kind")
+						assert.True(t, ok)
+						assert.Equal(t, "kind-val", kindAttrVal.Str())
+						moduleAttrVal, ok := dp.Attributes().Get("module")
+						assert.True(t, ok)
+						assert.Equal(t, "module-val", moduleAttrVal.Str())
+						moduleVersionAttrVal, ok := dp.Attributes().Get("module_version")
+						assert.True(t, ok)
+						assert.Equal(t, "module_version-val", moduleVersionAttrVal.Str())
+						nameAttrVal, ok := dp.Attributes().Get("name")
+						assert.True(t, ok)
+						assert.Equal(t, "name-val", nameAttrVal.Str())
+						namespaceAttrVal, ok := dp.Attributes().Get("namespace")
+						assert.True(t, ok)
+						assert.Equal(t, "namespace-val", namespaceAttrVal.Str())
+						stateAttrVal, ok := dp.Attributes().Get("state")
+						assert.True(t, ok)
+						assert.Equal(t, "state-val", stateAttrVal.Str())
+						versionAttrVal, ok := dp.Attributes().Get("version")
+						assert.True(t, ok)
+						assert.Equal(t, "version-val", versionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["kyma.module.status.state"], "Found a duplicate in the metrics slice: kyma.module.status.state")
+						validatedMetrics["kyma.module.status.state"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The state of a module listed in the status of a Kyma resource, metric value is 1 for the last scraped module state, including state as metric attribute.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["kyma.module.status.state"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("channel")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("fqdn")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("group")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("kind")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("module")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("module_version")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("namespace")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("state")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("version")
+						assert.False(t, ok)
+					}
+				case "kyma.receiver.leader":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["kyma.receiver.leader"], "Found a duplicate in the metrics slice: kyma.receiver.leader")
+						validatedMetrics["kyma.receiver.leader"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the receiver replica scrapes resources, 1 if it holds the leadership or no leader elector is configured, 0 otherwise.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						replicaAttrVal, ok := dp.Attributes().Get("replica")
+						assert.True(t, ok)
+						assert.Equal(t, "replica-val", replicaAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["kyma.receiver.leader"], "Found a duplicate in the metrics slice: kyma.receiver.leader")
+						validatedMetrics["kyma.receiver.leader"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the receiver replica scrapes resources, 1 if it holds the leadership or no leader elector is configured, 0 otherwise.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["kyma.receiver.leader"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("replica")
+						assert.False(t, ok)
+					}
+				case "kyma.resource.condition.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["kyma.resource.condition.count"], "Found a duplicate in the metrics slice: kyma.resource.condition.count")
+						validatedMetrics["kyma.resource.condition.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of resources per condition type and status, aggregated by group, version, kind, and namespace.", mi.Description())
+						assert.Equal(t, "{resource}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						groupAttrVal, ok := dp.Attributes().Get("group")
+						assert.True(t, ok)
+						assert.Equal(t, "group-val", groupAttrVal.Str())
+						kindAttrVal, ok := dp.Attributes().Get("kind")
+						assert.True(t, ok)
+						assert.Equal(t, "kind-val", kindAttrVal.Str())
+						namespaceAttrVal, ok := dp.Attributes().Get("namespace")
+						assert.True(t, ok)
+						assert.Equal(t, "namespace-val", namespaceAttrVal.Str())
+						statusAttrVal, ok := dp.Attributes().Get("status")
+						assert.True(t, ok)
+						assert.Equal(t, "status-val", statusAttrVal.Str())
+						typeAttrVal, ok := dp.Attributes().Get("type")
+						assert.True(t, ok)
+						assert.Equal(t, "type-val", typeAttrVal.Str())
+						versionAttrVal, ok := dp.Attributes().Get("version")
+						assert.True(t, ok)
+						assert.Equal(t, "version-val", versionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["kyma.resource.condition.count"], "Found a duplicate in the metrics slice: kyma.resource.condition.count")
+						validatedMetrics["kyma.resource.condition.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of resources per condition type and status, aggregated by group, version, kind, and namespace.", mi.Description())
+						assert.Equal(t, "{resource}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["kyma.resource.condition.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("group")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("kind")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("namespace")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("status")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("type")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("version")
+						assert.False(t, ok)
+					}
+				case "kyma.resource.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["kyma.resource.count"], "Found a duplicate in the metrics slice: kyma.resource.count")
+						validatedMetrics["kyma.resource.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of resources per state, aggregated by group, version, kind, and namespace. Resources without a state are counted with an empty state.", mi.Description())
+						assert.Equal(t, "{resource}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						groupAttrVal, ok := dp.Attributes().Get("group")
+						assert.True(t, ok)
+						assert.Equal(t, "group-val", groupAttrVal.Str())
+						kindAttrVal, ok := dp.Attributes().Get("kind")
+						assert.True(t, ok)
+						assert.Equal(t, "kind-val", kindAttrVal.Str())
+						namespaceAttrVal, ok := dp.Attributes().Get("namespace")
+						assert.True(t, ok)
+						assert.Equal(t, "namespace-val", namespaceAttrVal.Str())
+						stateAttrVal, ok := dp.Attributes().Get("state")
+						assert.True(t, ok)
+						assert.Equal(t, "state-val", stateAttrVal.Str())
+						versionAttrVal, ok := dp.Attributes().Get("version")
+						assert.True(t, ok)
+						assert.Equal(t, "version-val", versionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["kyma.resource.count"], "Found a duplicate in the metrics slice: kyma.resource.count")
+						validatedMetrics["kyma.resource.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of resources per state, aggregated by group, version, kind, and namespace. Resources without a state are counted with an empty state.", mi.Description())
+						assert.Equal(t, "{resource}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["kyma.resource.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("group")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("kind")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("namespace")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("state")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("version")
+						assert.False(t, ok)
+					}
+				case "kyma.resource.deletion.pending":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["kyma.resource.deletion.pending"], "Found a duplicate in the metrics slice: kyma.resource.deletion.pending")
+						validatedMetrics["kyma.resource.deletion.pending"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The time since the deletion of the resource was requested, in seconds. Only reported for resources with a deletionTimestamp, which are usually blocked by the listed finalizers.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						finalizersAttrVal, ok := dp.Attributes().Get("finalizers")
+						assert.True(t, ok)
+						assert.Equal(t, "finalizers-val", finalizersAttrVal.Str())
+						groupAttrVal, ok := dp.Attributes().Get("group")
+						assert.True(t, ok)
+						assert.Equal(t, "group-val", groupAttrVal.Str())
+						kindAttrVal, ok := dp.Attributes().Get("kind")
+						assert.True(t, ok)
+						assert.Equal(t, "kind-val", kindAttrVal.Str())
+						nameAttrVal, ok := dp.Attributes().Get("name")
+						assert.True(t, ok)
+						assert.Equal(t, "name-val", nameAttrVal.Str())
+						namespaceAttrVal, ok := dp.Attributes().Get("namespace")
+						assert.True(t, ok)
+						assert.Equal(t, "namespace-val", namespaceAttrVal.Str())
+						versionAttrVal, ok := dp.Attributes().Get("version")
+						assert.True(t, ok)
+						assert.Equal(t, "version-val", versionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["kyma.resource.deletion.pending"], "Found a duplicate in the metrics slice: kyma.resource.deletion.pending")
+						validatedMetrics["kyma.resource.deletion.pending"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The time since the deletion of the resource was requested, in seconds. Only reported for resources with a deletionTimestamp, which are usually blocked by the listed finalizers.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["kyma.resource.deletion.pending"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("finalizers")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("group")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("kind")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("namespace")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("version")
+						assert.False(t, ok)
+					}
+				case "kyma.resource.scrape.errors":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["kyma.resource.scrape.errors"], "Found a duplicate in the metrics slice: kyma.resource.scrape.errors")
+						validatedMetrics["kyma.resource.scrape.errors"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of failed list requests for the resource in the last scrape. A value greater than 0 indicates that the resource isn't collected, for example because the CRD is not installed or RBAC permissions are missing.", mi.Description())
+						assert.Equal(t, "{error}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						groupAttrVal, ok := dp.Attributes().Get("group")
+						assert.True(t, ok)
+						assert.Equal(t, "group-val", groupAttrVal.Str())
+						resourceAttrVal, ok := dp.Attributes().Get("resource")
+						assert.True(t, ok)
+						assert.Equal(t, "resource-val", resourceAttrVal.Str())
+						versionAttrVal, ok := dp.Attributes().Get("version")
+						assert.True(t, ok)
+						assert.Equal(t, "version-val", versionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["kyma.resource.scrape.errors"], "Found a duplicate in the metrics slice: kyma.resource.scrape.errors")
+						validatedMetrics["kyma.resource.scrape.errors"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of failed list requests for the resource in the last scrape. A value greater than 0 indicates that the resource isn't collected, for example because the CRD is not installed or RBAC permissions are missing.", mi.Description())
+						assert.Equal(t, "{error}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["kyma.resource.scrape.errors"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("group")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("resource")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("version")
+						assert.False(t, ok)
+					}
+				case "kyma.resource.status.condition.last_transition":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["kyma.resource.status.condition.last_transition"], "Found a duplicate in the metrics slice: kyma.resource.status.condition.last_transition")
+						validatedMetrics["kyma.resource.status.condition.last_transition"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The time of the last transition of the resource status condition, as seconds since the Unix epoch. Only reported for conditions with a lastTransitionTime.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						groupAttrVal, ok := dp.Attributes().Get("group")
+						assert.True(t, ok)
+						assert.Equal(t, "group-val", groupAttrVal.Str())
+						kindAttrVal, ok := dp.Attributes().Get("kind")
+						assert.True(t, ok)
+						assert.Equal(t, "kind-val", kindAttrVal.Str())
+						nameAttrVal, ok := dp.Attributes().Get("name")
+						assert.True(t, ok)
+						assert.Equal(t, "name-val", nameAttrVal.Str())
+						namespaceAttrVal, ok := dp.Attributes().Get("namespace")
+						assert.True(t, ok)
+						assert.Equal(t, "namespace-val", namespaceAttrVal.Str())
+						statusAttrVal, ok := dp.Attributes().Get("status")
+						assert.True(t, ok)
+						assert.Equal(t, "status-val", statusAttrVal.Str())
+						typeAttrVal, ok := dp.Attributes().Get("type")
+						assert.True(t, ok)
+						assert.Equal(t, "type-val", typeAttrVal.Str())
+						versionAttrVal, ok := dp.Attributes().Get("version")
+						assert.True(t, ok)
+						assert.Equal(t, "version-val", versionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["kyma.resource.status.condition.last_transition"], "Found a duplicate in the metrics slice: kyma.resource.status.condition.last_transition")
+						validatedMetrics["kyma.resource.status.condition.last_transition"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The time of the last transition of the resource status condition, as seconds since the Unix epoch. Only reported for conditions with a lastTransitionTime.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["kyma.resource.status.condition.last_transition"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("group")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("kind")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("namespace")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("status")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("type")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("version")
+						assert.False(t, ok)
+					}
 				case "kyma.resource.status.conditions":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["kyma.resource.status.conditions"], "Found a duplicate in the metrics slice: kyma.resource.status.conditions")
@@ -147,6 +659,13 @@ func TestMetricsBuilder(t *testing.T) {
 						kindAttrVal, ok := dp.Attributes().Get("kind")
 						assert.True(t, ok)
 						assert.Equal(t, "kind-val", kindAttrVal.Str())
+						messageAttrVal, ok := dp.Attributes().Get("message")
+						if tt.metricsSet == testDataSetAll {
+							assert.True(t, ok)
+							assert.Equal(t, "message-val", messageAttrVal.Str())
+						} else {
+							assert.False(t, ok)
+						}
 						nameAttrVal, ok := dp.Attributes().Get("name")
 						assert.True(t, ok)
 						assert.Equal(t, "name-val", nameAttrVal.Str())
@@ -190,6 +709,8 @@ func TestMetricsBuilder(t *testing.T) {
 						assert.False(t, ok)
 						_, ok = dp.Attributes().Get("kind")
 						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("message")
+						assert.False(t, ok)
 						_, ok = dp.Attributes().Get("name")
 						assert.False(t, ok)
 						_, ok = dp.Attributes().Get("namespace")
@@ -203,13 +724,133 @@ func TestMetricsBuilder(t *testing.T) {
 						_, ok = dp.Attributes().Get("version")
 						assert.False(t, ok)
 					}
+				case "kyma.resource.status.generation_lag":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["kyma.resource.status.generation_lag"], "Found a duplicate in the metrics slice: kyma.resource.status.generation_lag")
+						validatedMetrics["kyma.resource.status.generation_lag"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The difference between the resource metadata.generation and status.observedGeneration. A lag that doesn't go back to 0 indicates that the controller doesn't pick up spec changes. Only reported for resources with a status.observedGeneration.", mi.Description())
+						assert.Equal(t, "{generation}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						groupAttrVal, ok := dp.Attributes().Get("group")
+						assert.True(t, ok)
+						assert.Equal(t, "group-val", groupAttrVal.Str())
+						kindAttrVal, ok := dp.Attributes().Get("kind")
+						assert.True(t, ok)
+						assert.Equal(t, "kind-val", kindAttrVal.Str())
+						nameAttrVal, ok := dp.Attributes().Get("name")
+						assert.True(t, ok)
+						assert.Equal(t, "name-val", nameAttrVal.Str())
+						namespaceAttrVal, ok := dp.Attributes().Get("namespace")
+						assert.True(t, ok)
+						assert.Equal(t, "namespace-val", namespaceAttrVal.Str())
+						versionAttrVal, ok := dp.Attributes().Get("version")
+						assert.True(t, ok)
+						assert.Equal(t, "version-val", versionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["kyma.resource.status.generation_lag"], "Found a duplicate in the metrics slice: kyma.resource.status.generation_lag")
+						validatedMetrics["kyma.resource.status.generation_lag"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The difference between the resource metadata.generation and status.observedGeneration. A lag that doesn't go back to 0 indicates that the controller doesn't pick up spec changes. Only reported for resources with a status.observedGeneration.", mi.Description())
+						assert.Equal(t, "{generation}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["kyma.resource.status.generation_lag"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("group")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("kind")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("namespace")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("version")
+						assert.False(t, ok)
+					}
+				case "kyma.resource.status.reconciled":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["kyma.resource.status.reconciled"], "Found a duplicate in the metrics slice: kyma.resource.status.reconciled")
+						validatedMetrics["kyma.resource.status.reconciled"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the resource status.observedGeneration matches the metadata.generation. The metric value is 1 if the latest spec was reconciled, and 0 otherwise. Only reported for resources with a status.observedGeneration.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						groupAttrVal, ok := dp.Attributes().Get("group")
+						assert.True(t, ok)
+						assert.Equal(t, "group-val", groupAttrVal.Str())
+						kindAttrVal, ok := dp.Attributes().Get("kind")
+						assert.True(t, ok)
+						assert.Equal(t, "kind-val", kindAttrVal.Str())
+						nameAttrVal, ok := dp.Attributes().Get("name")
+						assert.True(t, ok)
+						assert.Equal(t, "name-val", nameAttrVal.Str())
+						namespaceAttrVal, ok := dp.Attributes().Get("namespace")
+						assert.True(t, ok)
+						assert.Equal(t, "namespace-val", namespaceAttrVal.Str())
+						versionAttrVal, ok := dp.Attributes().Get("version")
+						assert.True(t, ok)
+						assert.Equal(t, "version-val", versionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["kyma.resource.status.reconciled"], "Found a duplicate in the metrics slice: kyma.resource.status.reconciled")
+						validatedMetrics["kyma.resource.status.reconciled"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Whether the resource status.observedGeneration matches the metadata.generation. The metric value is 1 if the latest spec was reconciled, and 0 otherwise. Only reported for resources with a status.observedGeneration.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["kyma.resource.status.reconciled"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("group")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("kind")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("namespace")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("version")
+						assert.False(t, ok)
+					}
 				case "kyma.resource.status.state":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["kyma.resource.status.state"], "Found a duplicate in the metrics slice: kyma.resource.status.state")
 						validatedMetrics["kyma.resource.status.state"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The resource status state, metric value is 1 for the last scraped resource status state, including state as metric attribute.", mi.Description())
+						assert.Equal(t, "The resource status state, metric value is 1 for the last scraped resource status state, including state as metric attribute. If state_set is enabled, every other known state is reported with value 0.", mi.Description())
 						assert.Equal(t, "1", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
@@ -239,7 +880,7 @@ func TestMetricsBuilder(t *testing.T) {
 						validatedMetrics["kyma.resource.status.state"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The resource status state, metric value is 1 for the last scraped resource status state, including state as metric attribute.", mi.Description())
+						assert.Equal(t, "The resource status state, metric value is 1 for the last scraped resource status state, including state as metric attribute. If state_set is enabled, every other known state is reported with value 0.", mi.Description())
 						assert.Equal(t, "1", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
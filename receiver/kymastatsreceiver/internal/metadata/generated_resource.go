@@ -21,6 +21,20 @@ func NewResourceBuilder(rac ResourceAttributesConfig) *ResourceBuilder {
 	}
 }
 
+// SetK8sClusterName sets provided value as "k8s.cluster.name" attribute.
+func (rb *ResourceBuilder) SetK8sClusterName(val string) {
+	if rb.config.K8sClusterName.Enabled {
+		rb.res.Attributes().PutStr("k8s.cluster.name", val)
+	}
+}
+
+// SetK8sClusterUID sets provided value as "k8s.cluster.uid" attribute.
+func (rb *ResourceBuilder) SetK8sClusterUID(val string) {
+	if rb.config.K8sClusterUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.cluster.uid", val)
+	}
+}
+
 // SetK8sNamespaceName sets provided value as "k8s.namespace.name" attribute.
 func (rb *ResourceBuilder) SetK8sNamespaceName(val string) {
 	if rb.config.K8sNamespaceName.Enabled {
@@ -49,6 +63,55 @@ func (rb *ResourceBuilder) SetK8sResourceName(val string) {
 	}
 }
 
+// SetK8sResourceOwnerKind sets provided value as "k8s.resource.owner.kind" attribute.
+func (rb *ResourceBuilder) SetK8sResourceOwnerKind(val string) {
+	if rb.config.K8sResourceOwnerKind.Enabled {
+		rb.res.Attributes().PutStr("k8s.resource.owner.kind", val)
+	}
+}
+
+// SetK8sResourceOwnerName sets provided value as "k8s.resource.owner.name" attribute.
+func (rb *ResourceBuilder) SetK8sResourceOwnerName(val string) {
+	if rb.config.K8sResourceOwnerName.Enabled {
+		rb.res.Attributes().PutStr("k8s.resource.owner.name", val)
+	}
+}
+
+// SetK8sResourceOwnerUID sets provided value as "k8s.resource.owner.uid" attribute.
+func (rb *ResourceBuilder) SetK8sResourceOwnerUID(val string) {
+	if rb.config.K8sResourceOwnerUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.resource.owner.uid", val)
+	}
+}
+
+// SetK8sResourcePlural sets provided value as "k8s.resource.plural" attribute.
+func (rb *ResourceBuilder) SetK8sResourcePlural(val string) {
+	if rb.config.K8sResourcePlural.Enabled {
+		rb.res.Attributes().PutStr("k8s.resource.plural", val)
+	}
+}
+
+// SetK8sResourceTopOwnerKind sets provided value as "k8s.resource.top_owner.kind" attribute.
+func (rb *ResourceBuilder) SetK8sResourceTopOwnerKind(val string) {
+	if rb.config.K8sResourceTopOwnerKind.Enabled {
+		rb.res.Attributes().PutStr("k8s.resource.top_owner.kind", val)
+	}
+}
+
+// SetK8sResourceTopOwnerName sets provided value as "k8s.resource.top_owner.name" attribute.
+func (rb *ResourceBuilder) SetK8sResourceTopOwnerName(val string) {
+	if rb.config.K8sResourceTopOwnerName.Enabled {
+		rb.res.Attributes().PutStr("k8s.resource.top_owner.name", val)
+	}
+}
+
+// SetK8sResourceTopOwnerUID sets provided value as "k8s.resource.top_owner.uid" attribute.
+func (rb *ResourceBuilder) SetK8sResourceTopOwnerUID(val string) {
+	if rb.config.K8sResourceTopOwnerUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.resource.top_owner.uid", val)
+	}
+}
+
 // SetK8sResourceVersion sets provided value as "k8s.resource.version" attribute.
 func (rb *ResourceBuilder) SetK8sResourceVersion(val string) {
 	if rb.config.K8sResourceVersion.Enabled {
@@ -56,6 +119,13 @@ func (rb *ResourceBuilder) SetK8sResourceVersion(val string) {
 	}
 }
 
+// SetKymaReceiverShard sets provided value as "kyma.receiver.shard" attribute.
+func (rb *ResourceBuilder) SetKymaReceiverShard(val string) {
+	if rb.config.KymaReceiverShard.Enabled {
+		rb.res.Attributes().PutStr("kyma.receiver.shard", val)
+	}
+}
+
 // Emit returns the built resource and resets the internal builder state.
 func (rb *ResourceBuilder) Emit() pcommon.Resource {
 	r := rb.res
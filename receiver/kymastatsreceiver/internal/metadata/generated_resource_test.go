@@ -13,26 +13,46 @@ func TestResourceBuilder(t *testing.T) {
 		t.Run(tt, func(t *testing.T) {
 			cfg := loadResourceAttributesConfig(t, tt)
 			rb := NewResourceBuilder(cfg)
+			rb.SetK8sClusterName("k8s.cluster.name-val")
+			rb.SetK8sClusterUID("k8s.cluster.uid-val")
 			rb.SetK8sNamespaceName("k8s.namespace.name-val")
 			rb.SetK8sResourceGroup("k8s.resource.group-val")
 			rb.SetK8sResourceKind("k8s.resource.kind-val")
 			rb.SetK8sResourceName("k8s.resource.name-val")
+			rb.SetK8sResourceOwnerKind("k8s.resource.owner.kind-val")
+			rb.SetK8sResourceOwnerName("k8s.resource.owner.name-val")
+			rb.SetK8sResourceOwnerUID("k8s.resource.owner.uid-val")
+			rb.SetK8sResourcePlural("k8s.resource.plural-val")
+			rb.SetK8sResourceTopOwnerKind("k8s.resource.top_owner.kind-val")
+			rb.SetK8sResourceTopOwnerName("k8s.resource.top_owner.name-val")
+			rb.SetK8sResourceTopOwnerUID("k8s.resource.top_owner.uid-val")
 			rb.SetK8sResourceVersion("k8s.resource.version-val")
+			rb.SetKymaReceiverShard("kyma.receiver.shard-val")
 
 			res := rb.Emit()
 			assert.Equal(t, 0, rb.Emit().Attributes().Len()) // Second call should return empty Resource
 
 			switch tt {
 			case "default":
-				assert.Equal(t, 5, res.Attributes().Len())
+				assert.Equal(t, 7, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 5, res.Attributes().Len())
+				assert.Equal(t, 15, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
 			default:
 				assert.Failf(t, "unexpected test case: %s", tt)
 			}
+			k8sClusterNameAttrVal, ok := res.Attributes().Get("k8s.cluster.name")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.cluster.name-val", k8sClusterNameAttrVal.Str())
+			}
+			k8sClusterUIDAttrVal, ok := res.Attributes().Get("k8s.cluster.uid")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.cluster.uid-val", k8sClusterUIDAttrVal.Str())
+			}
 			k8sNamespaceNameAttrVal, ok := res.Attributes().Get("k8s.namespace.name")
 			assert.True(t, ok)
 			if ok {
@@ -53,11 +73,51 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.resource.name-val", k8sResourceNameAttrVal.Str())
 			}
+			k8sResourceOwnerKindAttrVal, ok := res.Attributes().Get("k8s.resource.owner.kind")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.resource.owner.kind-val", k8sResourceOwnerKindAttrVal.Str())
+			}
+			k8sResourceOwnerNameAttrVal, ok := res.Attributes().Get("k8s.resource.owner.name")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.resource.owner.name-val", k8sResourceOwnerNameAttrVal.Str())
+			}
+			k8sResourceOwnerUIDAttrVal, ok := res.Attributes().Get("k8s.resource.owner.uid")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.resource.owner.uid-val", k8sResourceOwnerUIDAttrVal.Str())
+			}
+			k8sResourcePluralAttrVal, ok := res.Attributes().Get("k8s.resource.plural")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.resource.plural-val", k8sResourcePluralAttrVal.Str())
+			}
+			k8sResourceTopOwnerKindAttrVal, ok := res.Attributes().Get("k8s.resource.top_owner.kind")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.resource.top_owner.kind-val", k8sResourceTopOwnerKindAttrVal.Str())
+			}
+			k8sResourceTopOwnerNameAttrVal, ok := res.Attributes().Get("k8s.resource.top_owner.name")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.resource.top_owner.name-val", k8sResourceTopOwnerNameAttrVal.Str())
+			}
+			k8sResourceTopOwnerUIDAttrVal, ok := res.Attributes().Get("k8s.resource.top_owner.uid")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.resource.top_owner.uid-val", k8sResourceTopOwnerUIDAttrVal.Str())
+			}
 			k8sResourceVersionAttrVal, ok := res.Attributes().Get("k8s.resource.version")
 			assert.True(t, ok)
 			if ok {
 				assert.Equal(t, "k8s.resource.version-val", k8sResourceVersionAttrVal.Str())
 			}
+			kymaReceiverShardAttrVal, ok := res.Attributes().Get("kyma.receiver.shard")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "kyma.receiver.shard-val", kymaReceiverShardAttrVal.Str())
+			}
 		})
 	}
 }
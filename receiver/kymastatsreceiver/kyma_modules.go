@@ -0,0 +1,67 @@
+package kymastatsreceiver
+
+import (
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	kymaGroup = "operator.kyma-project.io"
+	kymaKind  = "Kyma"
+)
+
+// moduleStatus is an entry of the `status.modules` list of a Kyma resource.
+type moduleStatus struct {
+	name    string
+	fqdn    string
+	channel string
+	version string
+	state   string
+}
+
+// isKyma reports whether the stats belong to the central Kyma resource, which lists the status of all its modules.
+func (s *resourceStats) isKyma() bool {
+	return s.group == kymaGroup && s.kind == kymaKind
+}
+
+// unstructuredToModules returns the module statuses of a Kyma resource. Entries without a name are skipped.
+func (ks *kymaScraper) unstructuredToModules(resource *unstructured.Unstructured) []moduleStatus {
+	unstructuredModules, found, err := unstructured.NestedSlice(resource.Object, "status", "modules")
+	if err != nil {
+		ks.logger.Debug("Error retrieving modules: modules are not a slice",
+			zap.Error(err),
+			zap.String("name", resource.GetName()),
+			zap.String("namespace", resource.GetNamespace()),
+		)
+
+		return nil
+	}
+
+	if !found {
+		return nil
+	}
+
+	var res []moduleStatus
+
+	for _, unstructuredModule := range unstructuredModules {
+		moduleMap, ok := unstructuredModule.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name, _, _ := unstructured.NestedString(moduleMap, "name")
+		if name == "" {
+			continue
+		}
+
+		module := moduleStatus{name: name}
+		module.fqdn, _, _ = unstructured.NestedString(moduleMap, "fqdn")
+		module.channel, _, _ = unstructured.NestedString(moduleMap, "channel")
+		module.version, _, _ = unstructured.NestedString(moduleMap, "version")
+		module.state, _, _ = unstructured.NestedString(moduleMap, "state")
+
+		res = append(res, module)
+	}
+
+	return res
+}
@@ -2,8 +2,15 @@ package kymastatsreceiver
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -13,7 +20,11 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/scraper"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -25,29 +36,77 @@ import (
 type kymaScraper struct {
 	config       Config
 	dynamic      dynamic.Interface
+	mapper       meta.RESTMapper
+	watcher      *resourceWatcher
+	configMap    *resourceConfigMap
+	discoverer   *resourceDiscoverer
+	labels       globMatcher
+	annotations  globMatcher
 	logger       *zap.Logger
+	buildInfo    component.BuildInfo
+	startTime    pcommon.Timestamp
 	mb           *metadata.MetricsBuilder
+	telemetry    *metadata.TelemetryBuilder
+	trigger      *scrapeTrigger
+	replica      string
+	cluster      *clusterIdentity
+	shard        *shard
 	shouldScrape atomic.Bool
+
+	// newClients creates the Kubernetes clients of a scraper created without them
+	newClients func() (dynamic.Interface, meta.RESTMapper, error)
+
+	// mu guards the clients, and the components using them, which are set once the clients have been created
+	mu      sync.Mutex
+	started bool
+
+	// fieldExtractors holds the parsed fields of the collected resources
+	fieldExtractors fieldExtractorCache
+
+	// snapshots holds the status of every resource seen in the previous logs scrape
+	snapshots map[objectKey]objectSnapshot
 }
 
 type resourceStats struct {
 	namespace string
 	name      string
+	uid       string
 
 	group   string
 	version string
 	kind    string
+	plural  string
+
+	state       string
+	conditions  []condition
+	fields      []fieldSample
+	modules     []moduleStatus
+	owner       *owner
+	topOwner    *owner
+	labels      map[string]string
+	annotations map[string]string
+
+	// objectLabels are all labels of the object, while labels only holds the labels matching the configured patterns
+	objectLabels map[string]string
 
-	state      string
-	conditions []condition
+	generation         int64
+	observedGeneration int64
 
-	hasState bool
+	// deletionTimestamp is set once the deletion of the resource was requested, which is blocked by its finalizers
+	deletionTimestamp time.Time
+	finalizers        []string
+
+	hasState              bool
+	hasObservedGeneration bool
 }
 
 type condition struct {
 	condType string
 	status   string
 	reason   string
+	message  string
+
+	lastTransitionTime time.Time
 }
 
 type fieldNotFoundError struct {
@@ -61,66 +120,392 @@ func (e *fieldNotFoundError) Error() string {
 func newKymaScraper(
 	config Config,
 	dynamic dynamic.Interface,
+	mapper meta.RESTMapper,
 	settings receiver.Settings,
+	opts ...scraperOption,
 ) (scraper.Metrics, error) {
-	ks := kymaScraper{
+	ks, err := newKymaScraperBase(config, dynamic, mapper, settings, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scraper.NewMetrics(ks.scrape, scraper.WithStart(ks.start), scraper.WithShutdown(ks.shutdown))
+}
+
+func newKymaLogsScraper(
+	config Config,
+	dynamic dynamic.Interface,
+	mapper meta.RESTMapper,
+	settings receiver.Settings,
+	opts ...scraperOption,
+) (scraper.Logs, error) {
+	ks, err := newKymaScraperBase(config, dynamic, mapper, settings, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scraper.NewLogs(ks.scrapeLogs, scraper.WithStart(ks.start), scraper.WithShutdown(ks.shutdown))
+}
+
+// scraperOption configures optional behavior of the scraper.
+type scraperOption func(ks *kymaScraper)
+
+// withClients lets the scraper create its Kubernetes clients when it starts instead of receiving them on creation,
+// so that the receiver can be created and started without access to a cluster.
+func withClients(newClients func() (dynamic.Interface, meta.RESTMapper, error)) scraperOption {
+	return func(ks *kymaScraper) {
+		ks.newClients = newClients
+	}
+}
+
+// withScrapeTrigger lets the scraper request an immediate scrape from the scraper controller when it becomes the leader.
+func withScrapeTrigger(trigger *scrapeTrigger) scraperOption {
+	return func(ks *kymaScraper) {
+		ks.trigger = trigger
+	}
+}
+
+func newKymaScraperBase(config Config, dynamic dynamic.Interface, mapper meta.RESTMapper, settings receiver.Settings, opts ...scraperOption) (*kymaScraper, error) {
+	telemetry, err := metadata.NewTelemetryBuilder(settings.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := &kymaScraper{
 		config:       config,
 		dynamic:      dynamic,
+		mapper:       mapper,
 		logger:       settings.Logger,
-		mb:           metadata.NewMetricsBuilder(config.MetricsBuilderConfig, settings),
+		buildInfo:    settings.BuildInfo,
+		startTime:    pcommon.NewTimestampFromTime(time.Now()),
+		labels:       newGlobMatcher(config.Labels),
+		annotations:  newGlobMatcher(config.Annotations),
+		telemetry:    telemetry,
+		cluster:      newClusterIdentity(dynamic, config.MetricsBuilderConfig, config.ClusterName, settings.Logger),
 		shouldScrape: atomic.Bool{},
 	}
 
-	return scraper.NewMetrics(ks.scrape, scraper.WithStart(ks.start))
+	for _, opt := range opts {
+		opt(ks)
+	}
+
+	ks.replica, err = os.Hostname()
+	if err != nil {
+		settings.Logger.Debug("Error retrieving hostname, reporting leadership without replica identity", zap.Error(err))
+	}
+
+	if config.Sharding.enabled() {
+		ks.shard, err = newShard(config.Sharding, ks.replica)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ks.mb = metadata.NewMetricsBuilder(config.MetricsBuilderConfig, settings, metadata.WithStartTime(ks.startTime))
+
+	if dynamic != nil {
+		ks.setClients(dynamic, mapper)
+	}
+
+	return ks, nil
 }
 
-func (ks *kymaScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
-	if !ks.shouldScrape.Load() {
-		return pmetric.NewMetrics(), nil
+// setClients sets the Kubernetes clients, together with the components using them. It must be called with the mutex held,
+// or before the scraper is shared.
+func (ks *kymaScraper) setClients(dynamic dynamic.Interface, mapper meta.RESTMapper) {
+	ks.dynamic = dynamic
+	ks.mapper = mapper
+	ks.cluster.dynamic = dynamic
+
+	if ks.config.Mode == ModeWatch {
+		ks.watcher = newResourceWatcher(dynamic, ks.config.Resources, ks.logger)
 	}
 
-	stats, err := ks.collectResourceStats(ctx)
+	if ks.config.ResourcesConfigMap != nil {
+		ks.configMap = newResourceConfigMap(dynamic, *ks.config.ResourcesConfigMap, ks.logger)
+	}
+
+	if ks.config.Discovery.enabled() {
+		ks.discoverer = newResourceDiscoverer(dynamic, ks.config.Discovery, ks.logger)
+	}
+}
+
+// connect creates the Kubernetes clients if the scraper was created without them. A failure doesn't stop the receiver,
+// the clients are created again on the next scrape, which reports the failure until then.
+func (ks *kymaScraper) connect() error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if ks.dynamic != nil {
+		return nil
+	}
+
+	if ks.newClients == nil {
+		return errors.New("no Kubernetes clients configured")
+	}
+
+	dynamic, mapper, err := ks.newClients()
 	if err != nil {
-		return pmetric.Metrics{}, err
+		return fmt.Errorf("failed to create Kubernetes clients: %w", err)
 	}
 
+	ks.setClients(dynamic, mapper)
+
+	// the components missed the start of the scraper, or the gain of leadership
+	if ks.started {
+		ks.startConfigMapLocked()
+
+		if ks.shouldScrape.Load() {
+			ks.startWatchingLocked()
+		}
+	}
+
+	return nil
+}
+
+func (ks *kymaScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	now := pcommon.NewTimestampFromTime(time.Now())
 
-	for _, s := range stats {
+	if !ks.shouldScrape.Load() {
+		return ks.emitLeader(now, false), nil
+	}
+
+	md := pmetric.NewMetrics()
+
+	counts := newResourceCounts()
+
+	failures, synced, err := ks.collect(ctx, func(s *resourceStats) {
+		counts.add(s)
+
 		if s.hasState {
-			ks.mb.RecordKymaResourceStatusStateDataPoint(now, int64(1), s.group, s.kind, s.name, s.namespace, s.state, s.version)
+			ks.recordState(now, s)
 		}
 
-		rb := ks.mb.NewResourceBuilder()
-		if s.namespace != "" {
-			rb.SetK8sNamespaceName(s.namespace)
+		if s.hasObservedGeneration {
+			ks.mb.RecordKymaResourceStatusGenerationLagDataPoint(now, s.generation-s.observedGeneration, s.group, s.kind, s.name, s.namespace, s.version)
+			ks.mb.RecordKymaResourceStatusReconciledDataPoint(now, boolToInt64(s.observedGeneration >= s.generation), s.group, s.kind, s.name, s.namespace, s.version)
 		}
 
-		rb.SetK8sResourceName(s.name)
-
-		rb.SetK8sResourceGroup(s.group)
-		rb.SetK8sResourceVersion(s.version)
-		rb.SetK8sResourceKind(s.kind)
-
 		for _, c := range s.conditions {
 			val := conditionStatusToValue(c.status)
-			ks.mb.RecordKymaResourceStatusConditionsDataPoint(now, val, s.group, s.kind, s.name, s.namespace, c.reason, c.status, c.condType, s.version)
+			message := truncateMessage(c.message, ks.config.Conditions.MessageMaxLength)
+			ks.mb.RecordKymaResourceStatusConditionsDataPoint(now, val, s.group, s.kind, message, s.name, s.namespace, c.reason, c.status, c.condType, s.version)
+
+			if !c.lastTransitionTime.IsZero() {
+				ks.mb.RecordKymaResourceStatusConditionLastTransitionDataPoint(now, c.lastTransitionTime.Unix(), s.group, s.kind, s.name, s.namespace, c.status, c.condType, s.version)
+			}
+		}
+
+		if !s.deletionTimestamp.IsZero() {
+			pending := now.AsTime().Sub(s.deletionTimestamp)
+			ks.mb.RecordKymaResourceDeletionPendingDataPoint(now, int64(pending.Seconds()), strings.Join(s.finalizers, ","), s.group, s.kind, s.name, s.namespace, s.version)
 		}
 
-		ks.mb.EmitForResource(metadata.WithResource(rb.Emit()))
+		for _, module := range s.modules {
+			ks.mb.RecordKymaModuleStatusStateDataPoint(now, int64(1), module.channel, module.fqdn, s.group, s.kind, module.name, module.version, s.name, s.namespace, module.state, s.version)
+		}
+
+		ks.emitForResource(md, ks.resource(s), now, s)
+	})
+	if err != nil && !scrapererror.IsPartialScrapeError(err) {
+		return pmetric.Metrics{}, err
 	}
 
+	if !synced {
+		return ks.emitLeader(now, true), nil
+	}
+
+	for gvr, failed := range failures {
+		ks.mb.RecordKymaResourceScrapeErrorsDataPoint(now, failed, gvr.Group, gvr.Resource, gvr.Version)
+	}
+
+	counts.record(ks.mb, now)
+
+	ks.emitLeader(now, true).ResourceMetrics().MoveAndAppendTo(md.ResourceMetrics())
+
 	// this condition tries to avoid duplicated metrics when just losing leadership
 	if !ks.shouldScrape.Load() {
-		return pmetric.NewMetrics(), nil
+		return ks.emitLeader(now, false), nil
+	}
+
+	return md, err
+}
+
+// emitLeader emits the metrics recorded without a Kubernetes resource, together with the leadership of the replica.
+// Their resource only carries the cluster identity.
+func (ks *kymaScraper) emitLeader(now pcommon.Timestamp, leader bool) pmetric.Metrics {
+	ks.mb.RecordKymaReceiverLeaderDataPoint(now, boolToInt64(leader), ks.replica)
+
+	return ks.mb.Emit(metadata.WithResource(ks.aggregateResource()))
+}
+
+// aggregateResource returns the telemetry resource of the metrics aggregated over the resource objects. Besides the
+// cluster identity, it carries the shard of the replica, so that the series of the sharding replicas don't collide.
+func (ks *kymaScraper) aggregateResource() pcommon.Resource {
+	rb := ks.mb.NewResourceBuilder()
+	ks.cluster.setAttributes(rb)
+
+	if ks.shard != nil {
+		rb.SetKymaReceiverShard(strconv.Itoa(ks.shard.ordinal))
+	}
+
+	return rb.Emit()
+}
+
+// clusterResource returns a telemetry resource that only carries the cluster identity.
+func (ks *kymaScraper) clusterResource() pcommon.Resource {
+	rb := ks.mb.NewResourceBuilder()
+	ks.cluster.setAttributes(rb)
+
+	return rb.Emit()
+}
+
+// recordState records the current state of the resource. In state set mode, every other known state is recorded with value 0.
+func (ks *kymaScraper) recordState(now pcommon.Timestamp, s *resourceStats) {
+	ks.mb.RecordKymaResourceStatusStateDataPoint(now, int64(1), s.group, s.kind, s.name, s.namespace, s.state, s.version)
+
+	if !ks.config.StateSet.Enabled {
+		return
 	}
 
-	return ks.mb.Emit(), nil
+	for _, state := range ks.config.StateSet.States {
+		if state != s.state {
+			ks.mb.RecordKymaResourceStatusStateDataPoint(now, int64(0), s.group, s.kind, s.name, s.namespace, state, s.version)
+		}
+	}
+}
+
+// collect passes the stats of every resource to fn, one resource at a time, and returns the number of failed list
+// requests per resource. If the resource watches are not synced yet, synced is false and fn is not called.
+// Resources that can't be listed, as well as a failed discovery, are reported as a partial scrape error.
+func (ks *kymaScraper) collect(ctx context.Context, fn func(s *resourceStats)) (failures map[schema.GroupVersionResource]int64, synced bool, err error) {
+	if err := ks.connect(); err != nil {
+		return nil, false, err
+	}
+
+	// avoid dropping the resources of the ConfigMap while it is still being loaded
+	if ks.configMap != nil && !ks.configMap.hasSynced() {
+		ks.logger.Debug("Skipping scrape, resource ConfigMap not synced yet")
+		return nil, false, nil
+	}
+
+	resources, discoveryErr := ks.resources(ctx)
+
+	ks.cluster.resolve(ctx)
+
+	if ks.watcher != nil {
+		ks.watcher.update(resources)
+
+		// avoid emitting an incomplete picture while the informers are still doing their initial list after the start,
+		// resources added later are reported as failed until their informer has synced
+		if !ks.watcher.hasSynced() {
+			ks.logger.Debug("Skipping scrape, resource watches not synced yet")
+			return nil, false, nil
+		}
+	}
+
+	failures, err = ks.collectResourceStats(ctx, resources, fn)
+	if discoveryErr == nil {
+		return failures, true, err
+	}
+
+	var errs scrapererror.ScrapeErrors
+	errs.AddPartial(1, discoveryErr)
+
+	var partialErr scrapererror.PartialScrapeError
+	if errors.As(err, &partialErr) {
+		errs.AddPartial(partialErr.Failed, err)
+	} else if err != nil {
+		errs.Add(err)
+	}
+
+	return failures, true, errs.Combine()
+}
+
+// resource returns the telemetry resource describing a Kubernetes resource.
+func (ks *kymaScraper) resource(s *resourceStats) pcommon.Resource {
+	rb := ks.mb.NewResourceBuilder()
+	if s.namespace != "" {
+		rb.SetK8sNamespaceName(s.namespace)
+	}
+
+	rb.SetK8sResourceName(s.name)
+
+	rb.SetK8sResourceGroup(s.group)
+	rb.SetK8sResourceVersion(s.version)
+	rb.SetK8sResourceKind(s.kind)
+	rb.SetK8sResourcePlural(s.plural)
+
+	ks.cluster.setAttributes(rb)
+
+	if s.owner != nil {
+		rb.SetK8sResourceOwnerKind(s.owner.kind)
+		rb.SetK8sResourceOwnerName(s.owner.name)
+		rb.SetK8sResourceOwnerUID(s.owner.uid)
+	}
+
+	if s.topOwner != nil {
+		rb.SetK8sResourceTopOwnerKind(s.topOwner.kind)
+		rb.SetK8sResourceTopOwnerName(s.topOwner.name)
+		rb.SetK8sResourceTopOwnerUID(s.topOwner.uid)
+	}
+
+	res := rb.Emit()
+
+	for k, v := range s.labels {
+		res.Attributes().PutStr("k8s.resource.label."+k, v)
+	}
+
+	for k, v := range s.annotations {
+		res.Attributes().PutStr("k8s.resource.annotation."+k, v)
+	}
+
+	return res
+}
+
+// emitForResource moves the metrics recorded for a single resource to md, together with its custom field metrics.
+func (ks *kymaScraper) emitForResource(md pmetric.Metrics, res pcommon.Resource, now pcommon.Timestamp, s *resourceStats) {
+	rms := ks.mb.Emit(metadata.WithResource(res)).ResourceMetrics()
+
+	if len(s.fields) > 0 {
+		if rms.Len() == 0 {
+			rm := rms.AppendEmpty()
+			res.CopyTo(rm.Resource())
+
+			sm := rm.ScopeMetrics().AppendEmpty()
+			sm.Scope().SetName(metadata.ScopeName)
+			sm.Scope().SetVersion(ks.buildInfo.Version)
+		}
+
+		appendFieldMetrics(rms.At(0).ScopeMetrics().At(0).Metrics(), ks.startTime, now, s)
+	}
+
+	rms.MoveAndAppendTo(md.ResourceMetrics())
 }
 
 func (ks *kymaScraper) start(ctx context.Context, host component.Host) error {
+	if ks.trigger != nil {
+		ks.trigger.start()
+	}
+
+	ks.mu.Lock()
+	ks.started = true
+	ks.mu.Unlock()
+
+	// a missing cluster doesn't fail the start, the scrapes report it and create the clients once it's available
+	if err := ks.connect(); err != nil {
+		ks.logger.Warn("Error creating Kubernetes clients, retrying on the next scrape", zap.Error(err))
+	}
+
+	// every replica keeps the ConfigMap loaded, so that a new leader can scrape right away
+	ks.mu.Lock()
+	ks.startConfigMapLocked()
+	ks.mu.Unlock()
+
 	if ks.config.K8sLeaderElector == nil {
-		ks.shouldScrape.Store(true)
+		ks.setLeader(ctx, true)
+		ks.startWatching()
+
 		return nil
 	}
 
@@ -139,57 +524,392 @@ func (ks *kymaScraper) start(ctx context.Context, host component.Host) error {
 		return errors.New("referenced extension is not k8s leader elector")
 	}
 
+	ks.setLeader(ctx, false)
+
 	leaderElectorExt.SetCallBackFuncs(
 		func(ctx context.Context) {
 			// scrape when elected as leader
-			ks.shouldScrape.Store(true)
+			ks.setLeader(ctx, true)
+			ks.startWatching()
+			ks.triggerScrape()
 		}, func() {
-			ks.shouldScrape.Store(false)
+			ks.setLeader(context.Background(), false)
+			ks.stopWatching()
 		},
 	)
 
 	return nil
 }
 
-func (ks *kymaScraper) collectResourceStats(ctx context.Context) ([]resourceStats, error) {
-	var res []resourceStats
+func (ks *kymaScraper) shutdown(_ context.Context) error {
+	if ks.trigger != nil {
+		ks.trigger.stop()
+	}
+
+	ks.mu.Lock()
+	ks.started = false
 
-	for _, resource := range ks.config.Resources {
-		gvr := schema.GroupVersionResource(resource)
+	ks.stopWatchingLocked()
 
-		resourceList, err := ks.dynamic.Resource(gvr).List(ctx, metav1.ListOptions{})
+	if ks.configMap != nil {
+		ks.configMap.stop()
+	}
+
+	ks.mu.Unlock()
+
+	ks.telemetry.Shutdown()
+
+	return nil
+}
+
+// setLeader enables or disables scraping, and reports whether the receiver scrapes in its own telemetry.
+func (ks *kymaScraper) setLeader(ctx context.Context, leader bool) {
+	ks.shouldScrape.Store(leader)
+	ks.telemetry.KymastatsLeader.Record(ctx, boolToInt64(leader))
+}
+
+// triggerScrape requests an immediate scrape instead of waiting for the next collection interval. In watch mode,
+// the scrape is requested once the informer caches are warm, as scrapes are skipped until then.
+func (ks *kymaScraper) triggerScrape() {
+	if ks.trigger == nil {
+		return
+	}
+
+	ks.mu.Lock()
+	watcher := ks.watcher
+	ks.mu.Unlock()
+
+	if watcher == nil {
+		ks.trigger.trigger()
+		return
+	}
+
+	ks.trigger.triggerWhenReady(watcher.hasSynced)
+}
+
+func (ks *kymaScraper) startWatching() {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	ks.startWatchingLocked()
+}
+
+func (ks *kymaScraper) stopWatching() {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	ks.stopWatchingLocked()
+}
+
+func (ks *kymaScraper) startWatchingLocked() {
+	if ks.watcher != nil {
+		ks.watcher.start()
+	}
+}
+
+func (ks *kymaScraper) stopWatchingLocked() {
+	if ks.watcher != nil {
+		ks.watcher.stop()
+	}
+}
+
+func (ks *kymaScraper) startConfigMapLocked() {
+	if ks.configMap != nil {
+		ks.configMap.start()
+	}
+}
+
+// resources returns the configured resources, followed by the resources of the ConfigMap and the discovered resources
+// that are not configured explicitly. If discovery fails, the other resources are returned with a partial scrape error.
+func (ks *kymaScraper) resources(ctx context.Context) ([]ResourceConfig, error) {
+	res := slices.Clone(ks.config.Resources)
+
+	if ks.configMap != nil {
+		res = appendMissingResources(res, ks.configMap.list())
+	}
+
+	if ks.discoverer != nil {
+		discovered, err := ks.discoverer.discover(ctx)
 		if err != nil {
+			// a failed discovery must not stop the collection of the configured resources
+			ks.logger.Error("Error discovering resources", zap.Error(err))
+			return res, scrapererror.NewPartialScrapeError(err, 1)
+		}
+
+		res = appendMissingResources(res, discovered)
+	}
+
+	return res, nil
+}
+
+// appendMissingResources appends the resources that are not in res yet. A resource is identified by its group and
+// resource name, so that the first setting of a resource wins, independent of its version.
+func appendMissingResources(res []ResourceConfig, resources []ResourceConfig) []ResourceConfig {
+	for _, resource := range resources {
+		present := slices.ContainsFunc(res, func(rc ResourceConfig) bool {
+			return rc.Group == resource.Group && rc.Resource == resource.Resource
+		})
+
+		if !present {
+			res = append(res, resource)
+		}
+	}
+
+	return res
+}
+
+// collectResourceStats passes the stats of every resource to fn. Resources are listed concurrently, up to the configured
+// number of concurrent requests, while fn is never called concurrently. A resource that can't be listed doesn't stop the
+// collection of the other resources, the failures are counted per resource and returned as a partial scrape error.
+func (ks *kymaScraper) collectResourceStats(ctx context.Context, resources []ResourceConfig, fn func(s *resourceStats)) (map[schema.GroupVersionResource]int64, error) {
+	extractors, err := ks.fieldExtractors.get(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		errs scrapererror.ScrapeErrors
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+
+	failures := make(map[schema.GroupVersionResource]int64, len(resources))
+	for _, resource := range resources {
+		failures[resource.gvr()] = 0
+	}
+
+	// fn records into the metrics builder, which is not safe for concurrent use
+	serialFn := func(s *resourceStats) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fn(s)
+	}
+
+	sem := make(chan struct{}, max(ks.config.MaxConcurrentRequests, 1))
+
+	var owners *ownerResolver
+	if ks.resolvesTopOwners() {
+		owners = newOwnerResolver(ks)
+	}
+
+	for i, resource := range resources {
+		wg.Go(func() {
+			failed, err := ks.acquireAndCollect(ctx, sem, resource, extractors[i], owners, serialFn)
+			if err == nil {
+				return
+			}
+
+			gvr := resource.gvr()
+
 			ks.logger.Error("Error fetching resource list",
 				zap.Error(err),
 				zap.String("group", gvr.Group),
 				zap.String("version", gvr.Version),
 				zap.String("resource", gvr.Resource))
 
-			return nil, err
+			mu.Lock()
+			defer mu.Unlock()
+
+			failures[gvr] += int64(failed)
+			errs.AddPartial(failed, fmt.Errorf("failed to list resource %s: %w", gvr, err))
+		})
+	}
+
+	wg.Wait()
+
+	return failures, errs.Combine()
+}
+
+// acquireAndCollect waits for a free slot of the semaphore and collects the stats of a single resource. If the scrape
+// context is done before a slot is free, the resource is counted as failed without listing it.
+func (ks *kymaScraper) acquireAndCollect(
+	ctx context.Context,
+	sem chan struct{},
+	resource ResourceConfig,
+	extractors []fieldExtractor,
+	owners *ownerResolver,
+	fn func(s *resourceStats),
+) (int, error) {
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		return 1, ctx.Err()
+	}
+
+	defer func() { <-sem }()
+
+	// a slot might have become free at the same time as the context was done
+	if err := ctx.Err(); err != nil {
+		return 1, err
+	}
+
+	return ks.collectResource(ctx, resource, extractors, owners, fn)
+}
+
+// collectResource passes the stats of every object of a single resource to fn. If owners is set, the top-level owner
+// of every object is resolved.
+func (ks *kymaScraper) collectResource(
+	ctx context.Context,
+	resource ResourceConfig,
+	extractors []fieldExtractor,
+	owners *ownerResolver,
+	fn func(s *resourceStats),
+) (int, error) {
+	gvr := resource.gvr()
+	kind := ks.kindFor(gvr)
+
+	start := time.Now()
+	objects := 0
+
+	defer func() {
+		attrs := metric.WithAttributes(
+			attribute.String("group", gvr.Group),
+			attribute.String("version", gvr.Version),
+			attribute.String("resource", gvr.Resource),
+		)
+		ks.telemetry.KymastatsListDuration.Record(ctx, time.Since(start).Seconds(), attrs)
+		ks.telemetry.KymastatsListedObjects.Add(ctx, int64(objects), attrs)
+	}()
+
+	return ks.listResources(ctx, resource, func(r *unstructured.Unstructured) {
+		objects++
+
+		if ks.shard != nil && !ks.shard.owns(r) {
+			return
 		}
 
-		for _, r := range resourceList.Items {
-			stats, err := ks.unstructuredToStats(r)
+		stats, err := ks.unstructuredToStats(*r)
+		if err != nil {
+			ks.recordConversionFailure("resource", err)
+			ks.logger.Warn("Error converting unstructured resource to stats",
+				zap.Error(err),
+				zap.String("name", r.GetName()),
+				zap.String("namespace", r.GetNamespace()),
+				zap.String("kind", r.GetKind()),
+			)
+
+			return
+		}
+
+		stats.group = gvr.Group
+		stats.version = gvr.Version
+		stats.plural = gvr.Resource
+
+		// fall back to the kind of the object if the REST mapper failed, and to the plural name as last resort
+		switch {
+		case kind != "":
+			stats.kind = kind
+		case stats.kind == "":
+			stats.kind = gvr.Resource
+		}
+		stats.labels = ks.labels.filter(r.GetLabels())
+		stats.annotations = ks.annotations.filter(r.GetAnnotations())
+
+		if stats.isKyma() {
+			stats.modules = ks.unstructuredToModules(r)
+		}
+
+		if ref := controllerOwner(r); ref != nil {
+			stats.owner = newOwner(ref)
+
+			if owners != nil {
+				stats.topOwner = owners.topOwner(ctx, r.GetNamespace(), ref)
+			}
+		}
+
+		for i := range extractors {
+			samples, err := extractors[i].extract(r.Object)
 			if err != nil {
-				ks.logger.Warn("Error converting unstructured resource to stats",
+				ks.logger.Debug("Error extracting fields from resource",
 					zap.Error(err),
 					zap.String("name", r.GetName()),
 					zap.String("namespace", r.GetNamespace()),
 					zap.String("kind", r.GetKind()),
 				)
+			}
+
+			stats.fields = append(stats.fields, samples...)
+		}
+
+		fn(stats)
+	})
+}
+
+// resolvesTopOwners reports whether any top-level owner attribute is enabled, which requires retrieving the owners.
+func (ks *kymaScraper) resolvesTopOwners() bool {
+	attrs := ks.config.ResourceAttributes
+
+	return attrs.K8sResourceTopOwnerKind.Enabled || attrs.K8sResourceTopOwnerName.Enabled || attrs.K8sResourceTopOwnerUID.Enabled
+}
+
+// kindFor returns the kind of the resource, or an empty string if the REST mapper can't resolve it.
+func (ks *kymaScraper) kindFor(gvr schema.GroupVersionResource) string {
+	gvk, err := ks.mapper.KindFor(gvr)
+	if err != nil {
+		ks.logger.Debug("Error resolving resource kind",
+			zap.Error(err),
+			zap.String("group", gvr.Group),
+			zap.String("version", gvr.Version),
+			zap.String("resource", gvr.Resource))
+
+		return ""
+	}
+
+	return gvk.Kind
+}
+
+// listResources passes every object of the resource to fn. In pull mode, the objects are listed page by page
+// if a page size is configured, so that only a single page is kept in memory. A namespace that can't be listed
+// doesn't stop the listing of the other namespaces, the number of failed namespaces is returned with the errors.
+func (ks *kymaScraper) listResources(ctx context.Context, resource ResourceConfig, fn func(r *unstructured.Unstructured)) (int, error) {
+	if ks.watcher != nil {
+		items, err := ks.watcher.list(resource)
+		if err != nil {
+			return 1, err
+		}
+
+		for i := range items {
+			fn(&items[i])
+		}
+
+		return 0, nil
+	}
+
+	var errs []error
+
+	for _, namespace := range resource.namespaces() {
+		if err := ks.listNamespace(ctx, resource, namespace, fn); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return len(errs), errors.Join(errs...)
+}
+
+func (ks *kymaScraper) listNamespace(ctx context.Context, resource ResourceConfig, namespace string, fn func(r *unstructured.Unstructured)) error {
+	opts := metav1.ListOptions{Limit: ks.config.PageSize}
+	resource.applyListOptions(&opts)
+
+	for {
+		resourceList, err := ks.dynamic.Resource(resource.gvr()).Namespace(namespace).List(ctx, opts)
+		if err != nil {
+			return err
+		}
 
+		for i := range resourceList.Items {
+			if resource.isNamespaceExcluded(resourceList.Items[i].GetNamespace()) {
 				continue
 			}
 
-			stats.group = gvr.Group
-			stats.version = gvr.Version
-			stats.kind = gvr.Resource
+			fn(&resourceList.Items[i])
+		}
 
-			res = append(res, *stats)
+		opts.Continue = resourceList.GetContinue()
+		if opts.Continue == "" {
+			return nil
 		}
 	}
-
-	return res, nil
 }
 
 func (ks *kymaScraper) unstructuredToStats(resource unstructured.Unstructured) (*resourceStats, error) {
@@ -227,8 +947,30 @@ func (ks *kymaScraper) unstructuredToStats(resource unstructured.Unstructured) (
 		namespace: resource.GetNamespace(),
 		kind:      resource.GetKind(),
 		name:      resource.GetName(),
+		uid:       string(resource.GetUID()),
+
+		objectLabels: resource.GetLabels(),
+		generation:   resource.GetGeneration(),
+		finalizers:   resource.GetFinalizers(),
+	}
+
+	if deletionTimestamp := resource.GetDeletionTimestamp(); deletionTimestamp != nil {
+		stats.deletionTimestamp = deletionTimestamp.Time
 	}
 
+	observedGeneration, found, err := unstructured.NestedInt64(status, "observedGeneration")
+	if err != nil {
+		ks.logger.Debug("Error retrieving observed generation: observed generation is not an integer",
+			zap.Error(err),
+			zap.String("name", resource.GetName()),
+			zap.String("namespace", resource.GetNamespace()),
+			zap.String("kind", resource.GetKind()),
+		)
+	}
+
+	stats.observedGeneration = observedGeneration
+	stats.hasObservedGeneration = found && err == nil
+
 	unstructuredConds, found, err := unstructured.NestedSlice(status, "conditions")
 	if err != nil {
 		ks.logger.Debug("Error retrieving conditions: conditions are not a slice",
@@ -255,7 +997,9 @@ func (ks *kymaScraper) unstructuredToStats(resource unstructured.Unstructured) (
 	for _, unstructuredCond := range unstructuredConds {
 		cond, err := ks.unstructuredToCondition(unstructuredCond)
 		if err != nil {
-			ks.logger.Warn("Error converting unstructured resource to stats, condition not supported",
+			// counted in the internal telemetry, so that invalid conditions don't flood the logs on every scrape
+			ks.recordConversionFailure("condition", err)
+			ks.logger.Debug("Error converting unstructured resource to stats, condition not supported",
 				zap.Error(err),
 				zap.String("name", resource.GetName()),
 				zap.String("namespace", resource.GetNamespace()),
@@ -295,20 +1039,46 @@ func (ks *kymaScraper) unstructuredToCondition(cond any) (*condition, error) {
 		return nil, &fieldNotFoundError{"status"}
 	}
 
+	// many controllers leave the reason empty, for example for conditions with status Unknown
 	reason, found, err := unstructured.NestedString(condMap, "reason")
 	if err != nil {
 		return nil, err
 	}
 
-	if !found {
+	if !found && ks.config.Conditions.Strict {
 		return nil, &fieldNotFoundError{"reason"}
 	}
 
-	return &condition{
+	res := &condition{
 		condType: condType,
 		status:   status,
 		reason:   reason,
-	}, nil
+	}
+
+	res.message, _, _ = unstructured.NestedString(condMap, "message")
+
+	// lastTransitionTime is optional, conditions without a valid timestamp are still reported
+	lastTransitionTime, found, _ := unstructured.NestedString(condMap, "lastTransitionTime")
+	if found {
+		if t, err := time.Parse(time.RFC3339, lastTransitionTime); err == nil {
+			res.lastTransitionTime = t
+		}
+	}
+
+	return res, nil
+}
+
+// recordConversionFailure counts a resource object or condition that couldn't be converted. The reason is either
+// a missing field, for example `condition_reason_not_found`, or `<object>_invalid` for any other error.
+func (ks *kymaScraper) recordConversionFailure(object string, err error) {
+	reason := object + "_invalid"
+
+	var notFound *fieldNotFoundError
+	if errors.As(err, &notFound) {
+		reason = object + "_" + notFound.field + "_not_found"
+	}
+
+	ks.telemetry.KymastatsConversionFailures.Add(context.Background(), 1, metric.WithAttributes(attribute.String("reason", reason)))
 }
 
 func conditionStatusToValue(status string) int64 {
@@ -321,3 +1091,24 @@ func conditionStatusToValue(status string) int64 {
 		return -1
 	}
 }
+
+// truncateMessage limits a condition message to maxLength characters. A longer message is truncated and suffixed with
+// a short hash of the full message, which keeps messages that only differ after the cut distinguishable.
+func truncateMessage(message string, maxLength int) string {
+	runes := []rune(message)
+	if len(runes) <= maxLength {
+		return message
+	}
+
+	sum := sha256.Sum256([]byte(message))
+
+	return string(runes[:maxLength]) + "..." + hex.EncodeToString(sum[:4])
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
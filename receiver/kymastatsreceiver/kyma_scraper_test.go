@@ -1,24 +1,44 @@
 package kymastatsreceiver
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
 	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
 
 	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/k8sleaderelectortest"
 	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadatatest"
 )
 
 const (
@@ -83,8 +103,8 @@ func TestScrape(t *testing.T) {
 
 	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme,
 		map[schema.GroupVersionResource]string{
-			schema.GroupVersionResource(resources[0]): "TelemetryList",
-			schema.GroupVersionResource(resources[1]): "LogPipelineList",
+			resources[0].gvr(): "TelemetryList",
+			resources[1].gvr(): "LogPipelineList",
 		}, &unstructured.Unstructured{
 			Object: telemetry,
 		},
@@ -102,6 +122,7 @@ func TestScrape(t *testing.T) {
 			Resources:            resources,
 		},
 		dynamic,
+		newTestRESTMapper(),
 		receivertest.NewNopSettings(metadata.Type),
 	)
 	require.NoError(t, err)
@@ -150,6 +171,7 @@ func TestScrape_CantPullResource(t *testing.T) {
 			Resources:            resources,
 		},
 		dynamic,
+		newTestRESTMapper(),
 		receivertest.NewNopSettings(metadata.Type))
 
 	require.NoError(t, err)
@@ -158,6 +180,150 @@ func TestScrape_CantPullResource(t *testing.T) {
 
 	_, err = r.ScrapeMetrics(t.Context())
 	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+}
+
+func TestScrape_ClientsUnavailable(t *testing.T) {
+	for _, mode := range []Mode{ModePull, ModeWatch} {
+		t.Run(string(mode), func(t *testing.T) {
+			resources := []ResourceConfig{
+				{
+					Group:    telemetryResourceGroup,
+					Version:  telemetryResourceVersion,
+					Resource: "telemetries",
+				},
+			}
+
+			client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					resources[0].gvr(): "TelemetryList",
+				},
+				newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+			)
+
+			clientsErr := errors.New("unable to load k8s config")
+
+			r, err := newKymaScraper(
+				Config{
+					MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+					Resources:            resources,
+					Mode:                 mode,
+				},
+				nil,
+				nil,
+				receivertest.NewNopSettings(metadata.Type),
+				withClients(func() (dynamic.Interface, meta.RESTMapper, error) {
+					if clientsErr != nil {
+						return nil, nil, clientsErr
+					}
+
+					return client, newTestRESTMapper(), nil
+				}),
+			)
+			require.NoError(t, err)
+
+			// a missing cluster doesn't fail the start
+			require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+			defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+			_, err = r.ScrapeMetrics(t.Context())
+			require.ErrorIs(t, err, clientsErr)
+
+			// the clients are created on a later scrape, the watches start right away
+			clientsErr = nil
+
+			require.Eventually(t, func() bool {
+				md, err := r.ScrapeMetrics(t.Context())
+				return err == nil && slices.Equal([]string{"default"}, scrapedObjects(md))
+			}, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestScrape_PartialScrape(t *testing.T) {
+	for _, mode := range []Mode{ModePull, ModeWatch} {
+		t.Run(string(mode), func(t *testing.T) {
+			resources := []ResourceConfig{
+				{
+					Group:    telemetryResourceGroup,
+					Version:  telemetryResourceVersion,
+					Resource: "telemetries",
+				},
+				{
+					Group:    logPipelineResourceGroup,
+					Version:  logPipelineResourceVersion,
+					Resource: "logpipelines",
+				},
+			}
+
+			dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					resources[0].gvr(): "TelemetryList",
+					resources[1].gvr(): "LogPipelineList",
+				},
+				newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+			)
+
+			dynamic.PrependReactor("list", "logpipelines", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New("the server could not find the requested resource")
+			})
+
+			r, err := newKymaScraper(
+				Config{
+					MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+					Resources:            resources,
+					Mode:                 mode,
+				},
+				dynamic,
+				newTestRESTMapper(),
+				receivertest.NewNopSettings(metadata.Type),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+			defer func() {
+				require.NoError(t, r.Shutdown(t.Context()))
+			}()
+
+			var md pmetric.Metrics
+
+			require.Eventually(t, func() bool {
+				md, err = r.ScrapeMetrics(t.Context())
+				return md.ResourceMetrics().Len() > 0
+			}, 5*time.Second, 10*time.Millisecond)
+
+			require.True(t, scrapererror.IsPartialScrapeError(err))
+
+			var (
+				names    []string
+				failures = make(map[string]int64)
+			)
+
+			for i := 0; i < md.ResourceMetrics().Len(); i++ {
+				rm := md.ResourceMetrics().At(i)
+				if name, ok := rm.Resource().Attributes().Get("k8s.resource.name"); ok {
+					names = append(names, name.Str())
+					continue
+				}
+
+				metrics := rm.ScopeMetrics().At(0).Metrics()
+				for j := 0; j < metrics.Len(); j++ {
+					if metrics.At(j).Name() != "kyma.resource.scrape.errors" {
+						continue
+					}
+
+					dps := metrics.At(j).Gauge().DataPoints()
+					for k := 0; k < dps.Len(); k++ {
+						resource, _ := dps.At(k).Attributes().Get("resource")
+						failures[resource.Str()] = dps.At(k).IntValue()
+					}
+				}
+			}
+
+			require.Equal(t, []string{"default"}, names)
+			require.Equal(t, map[string]int64{"telemetries": 0, "logpipelines": 1}, failures)
+		})
+	}
 }
 
 func TestScrape_HandlesInvalidResourceGracefully(t *testing.T) {
@@ -166,6 +332,7 @@ func TestScrape_HandlesInvalidResourceGracefully(t *testing.T) {
 	tests := []struct {
 		name               string
 		status             any
+		strict             bool
 		expectedDataPoints int
 	}{
 		{
@@ -280,10 +447,24 @@ func TestScrape_HandlesInvalidResourceGracefully(t *testing.T) {
 				"conditions": []any{
 					map[string]any{
 						"type":   "FakeConditionType",
-						"status": "False",
+						"status": "Unknown",
+					},
+				},
+			},
+			expectedDataPoints: 2,
+		},
+		{
+			name: "no condition reason in strict mode",
+			status: map[string]any{
+				"state": "Ready",
+				"conditions": []any{
+					map[string]any{
+						"type":   "FakeConditionType",
+						"status": "Unknown",
 					},
 				},
 			},
+			strict:             true,
 			expectedDataPoints: 1,
 		},
 		{
@@ -324,10 +505,12 @@ func TestScrape_HandlesInvalidResourceGracefully(t *testing.T) {
 
 			r, err := newKymaScraper(
 				Config{
-					MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+					MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
 					Resources:            resources,
+					Conditions:           ConditionsConfig{Strict: tt.strict},
 				},
 				dynamic,
+				newTestRESTMapper(),
 				receivertest.NewNopSettings(metadata.Type))
 
 			require.NoError(t, err)
@@ -372,7 +555,7 @@ func TestScrapeWithLeaderElection(t *testing.T) {
 
 	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme,
 		map[schema.GroupVersionResource]string{
-			schema.GroupVersionResource(resources[0]): "TelemetryList",
+			resources[0].gvr(): "TelemetryList",
 		}, &unstructured.Unstructured{
 			Object: telemetry,
 		},
@@ -385,6 +568,7 @@ func TestScrapeWithLeaderElection(t *testing.T) {
 			K8sLeaderElector:     &leaderElectorID,
 		},
 		dynamic,
+		newTestRESTMapper(),
 		receivertest.NewNopSettings(metadata.Type))
 
 	require.NoError(t, err)
@@ -411,27 +595,2171 @@ func TestScrapeWithLeaderElection(t *testing.T) {
 	require.Zero(t, md.DataPointCount())
 }
 
-func newUnstructuredObject(kind, resourceType, name string) map[string]any {
-	if resourceType == "telemetry" {
-		return map[string]any{
-			"apiVersion": telemetryResourceGroup + "/" + telemetryResourceVersion,
-			"kind":       kind,
-			"metadata": map[string]any{
-				"namespace": telemetryResourceNamespace,
-				"name":      name,
-			},
+func TestScrapeWithLeaderElection_TriggersScrape(t *testing.T) {
+	for _, mode := range []Mode{ModePull, ModeWatch} {
+		t.Run(string(mode), func(t *testing.T) {
+			fakeLeaderElection := &k8sleaderelectortest.FakeLeaderElection{}
+			leaderElectorID := component.MustNewID("k8s_leader_elector")
+			fakeHost := &k8sleaderelectortest.FakeHost{
+				FakeLeaderElection: fakeLeaderElection,
+			}
+
+			resources := []ResourceConfig{
+				{
+					Group:    telemetryResourceGroup,
+					Version:  telemetryResourceVersion,
+					Resource: "telemetries",
+				},
+			}
+
+			dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					resources[0].gvr(): "TelemetryList",
+				},
+				newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+			)
+
+			trigger := newScrapeTrigger(time.Hour)
+
+			r, err := newKymaScraper(
+				Config{
+					MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+					Resources:            resources,
+					K8sLeaderElector:     &leaderElectorID,
+					Mode:                 mode,
+				},
+				dynamic,
+				newTestRESTMapper(),
+				receivertest.NewNopSettings(metadata.Type),
+				withScrapeTrigger(trigger),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, r.Start(t.Context(), fakeHost))
+			defer func() { require.NoError(t, r.Shutdown(context.Background())) }()
+
+			fakeLeaderElection.InvokeOnLeading()
+
+			select {
+			case <-trigger.C():
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "no scrape requested after gaining leadership")
+			}
+
+			md, err := r.ScrapeMetrics(t.Context())
+			require.NoError(t, err)
+			require.NotZero(t, md.DataPointCount())
+		})
+	}
+}
+
+func TestScrapeWithLeaderElection_LeaderMetric(t *testing.T) {
+	fakeLeaderElection := &k8sleaderelectortest.FakeLeaderElection{}
+	leaderElectorID := component.MustNewID("k8s_leader_elector")
+	fakeHost := &k8sleaderelectortest.FakeHost{
+		FakeLeaderElection: fakeLeaderElection,
+	}
+
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+	)
+
+	mbc := metadata.NewDefaultMetricsBuilderConfig()
+	mbc.Metrics.KymaReceiverLeader.Enabled = true
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: mbc,
+			Resources:            resources,
+			K8sLeaderElector:     &leaderElectorID,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), fakeHost))
+
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+
+	leaderValue := func(md pmetric.Metrics) int64 {
+		t.Helper()
+
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			metrics := md.ResourceMetrics().At(i).ScopeMetrics().At(0).Metrics()
+			for j := 0; j < metrics.Len(); j++ {
+				m := metrics.At(j)
+				if m.Name() != "kyma.receiver.leader" {
+					continue
+				}
+
+				require.Equal(t, 1, m.Gauge().DataPoints().Len())
+
+				replica, _ := m.Gauge().DataPoints().At(0).Attributes().Get("replica")
+				require.Equal(t, hostname, replica.Str())
+
+				return m.Gauge().DataPoints().At(0).IntValue()
+			}
 		}
+
+		require.Fail(t, "leader metric not found")
+
+		return 0
 	}
 
-	if resourceType == "logpipeline" {
-		return map[string]any{
-			"apiVersion": logPipelineResourceGroup + "/" + logPipelineResourceVersion,
-			"kind":       kind,
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.DataPointCount())
+	require.Equal(t, int64(0), leaderValue(md))
+
+	fakeLeaderElection.InvokeOnLeading()
+
+	md, err = r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Greater(t, md.DataPointCount(), 1)
+	require.Equal(t, int64(1), leaderValue(md))
+
+	fakeLeaderElection.InvokeOnStopping()
+
+	md, err = r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.DataPointCount())
+	require.Equal(t, int64(0), leaderValue(md))
+}
+
+func TestScrape_WatchMode(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	scheme := runtime.NewScheme()
+
+	telemetry := newUnstructuredObject("Telemetry", "telemetry", "default")
+	unstructured.SetNestedMap(telemetry, map[string]any{
+		"state": "Ready",
+	}, "status")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		}, &unstructured.Unstructured{
+			Object: telemetry,
+		},
+	)
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+			Mode:                 ModeWatch,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, r.Shutdown(t.Context()))
+	}()
+
+	require.Eventually(t, func() bool {
+		md, err := r.ScrapeMetrics(t.Context())
+		return err == nil && md.DataPointCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the scrape must not hit the API server once the cache is synced
+	listCalls := countListActions(dynamic)
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.DataPointCount())
+	require.Equal(t, listCalls, countListActions(dynamic))
+}
+
+func TestScrape_ResourcesConfigMap(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	logPipelines := ResourceConfig{
+		Group:    logPipelineResourceGroup,
+		Version:  logPipelineResourceVersion,
+		Resource: "logpipelines",
+	}
+
+	newConfigMap := func(data string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
 			"metadata": map[string]any{
-				"name": name,
+				"namespace": "kyma-system",
+				"name":      "kymastats-resources",
+			},
+			"data": map[string]any{
+				"resources.yaml": data,
+			},
+		}}
+	}
+
+	logPipelinesData := `
+resources:
+  - group: telemetry.kyma-project.io
+    version: v1alpha1
+    resource: logpipelines
+`
+
+	telemetry := &unstructured.Unstructured{Object: newUnstructuredObject("Telemetry", "telemetry", "default")}
+	unstructured.SetNestedField(telemetry.Object, "Ready", "status", "state")
+
+	logPipeline := &unstructured.Unstructured{Object: newUnstructuredObject("LogPipeline", "logpipeline", "default")}
+	unstructured.SetNestedField(logPipeline.Object, "Ready", "status", "state")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+			logPipelines.gvr(): "LogPipelineList",
+			configMapsGVR:      "ConfigMapList",
+		},
+		telemetry, logPipeline, newConfigMap(logPipelinesData),
+	)
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+			ResourcesConfigMap: &ConfigMapKeyConfig{
+				Namespace: "kyma-system",
+				Name:      "kymastats-resources",
+				Key:       "resources.yaml",
 			},
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, r.Shutdown(context.Background()))
+	}()
+
+	scrapedKinds := func() []string {
+		md, err := r.ScrapeMetrics(t.Context())
+		if err != nil {
+			return nil
+		}
+
+		var kinds []string
+
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			kind, _ := md.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.resource.kind")
+			kinds = append(kinds, kind.Str())
 		}
+
+		slices.Sort(kinds)
+
+		return kinds
 	}
 
-	return nil
+	updateConfigMap := func(data string) {
+		_, err := dynamic.Resource(configMapsGVR).Namespace("kyma-system").Update(t.Context(), newConfigMap(data), metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	// the resources of the ConfigMap are collected together with the configured resources
+	require.Eventually(t, func() bool {
+		return slices.Equal([]string{"LogPipeline", "Telemetry"}, scrapedKinds())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// an invalid ConfigMap keeps the previously loaded resources
+	updateConfigMap("resources: [")
+	require.Never(t, func() bool {
+		return !slices.Equal([]string{"LogPipeline", "Telemetry"}, scrapedKinds())
+	}, 200*time.Millisecond, 10*time.Millisecond)
+
+	// resources removed from the ConfigMap are no longer collected
+	updateConfigMap("resources: []")
+	require.Eventually(t, func() bool {
+		return slices.Equal([]string{"Telemetry"}, scrapedKinds())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	updateConfigMap(logPipelinesData)
+	require.Eventually(t, func() bool {
+		return slices.Equal([]string{"LogPipeline", "Telemetry"}, scrapedKinds())
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// deleting the ConfigMap removes its resources
+	require.NoError(t, dynamic.Resource(configMapsGVR).Namespace("kyma-system").Delete(t.Context(), "kymastats-resources", metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		return slices.Equal([]string{"Telemetry"}, scrapedKinds())
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestScrape_WatchModeResourceAdded(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	configMap := func(data string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]any{
+				"namespace": "kyma-system",
+				"name":      "kymastats-resources",
+			},
+			"data": map[string]any{
+				"resources.yaml": data,
+			},
+		}}
+	}
+
+	logPipelines := ResourceConfig{
+		Group:    logPipelineResourceGroup,
+		Version:  logPipelineResourceVersion,
+		Resource: "logpipelines",
+	}
+
+	telemetry := &unstructured.Unstructured{Object: newUnstructuredObject("Telemetry", "telemetry", "default")}
+	unstructured.SetNestedField(telemetry.Object, "Ready", "status", "state")
+
+	logPipeline := &unstructured.Unstructured{Object: newUnstructuredObject("LogPipeline", "logpipeline", "application-logs")}
+	unstructured.SetNestedField(logPipeline.Object, "Ready", "status", "state")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+			logPipelines.gvr(): "LogPipelineList",
+			configMapsGVR:      "ConfigMapList",
+		},
+		telemetry, logPipeline, configMap("resources: []"),
+	)
+
+	// holds back the initial list of the added resource
+	release := make(chan struct{})
+	dynamic.PrependReactor("list", "logpipelines", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		<-release
+		return false, nil, nil
+	})
+
+	mbc := newResourceMetricsBuilderConfig()
+	mbc.Metrics.KymaResourceScrapeErrors.Enabled = true
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: mbc,
+			Resources:            resources,
+			Mode:                 ModeWatch,
+			ResourcesConfigMap: &ConfigMapKeyConfig{
+				Namespace: "kyma-system",
+				Name:      "kymastats-resources",
+				Key:       "resources.yaml",
+			},
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, r.Shutdown(context.Background()))
+	}()
+	defer close(release)
+
+	require.Eventually(t, func() bool {
+		md, err := r.ScrapeMetrics(t.Context())
+		return err == nil && slices.Equal([]string{"default"}, scrapedObjects(md))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = dynamic.Resource(configMapsGVR).Namespace("kyma-system").Update(t.Context(), configMap(`
+resources:
+  - group: telemetry.kyma-project.io
+    version: v1alpha1
+    resource: logpipelines
+`), metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// the synced resources are still collected, while the added resource is reported as failed until its watch has synced
+	require.Eventually(t, func() bool {
+		md, err := r.ScrapeMetrics(t.Context())
+		return scrapererror.IsPartialScrapeError(err) &&
+			slices.Equal([]string{"default"}, scrapedObjects(md)) &&
+			maps.Equal(map[string]int64{"logpipelines": 1, "telemetries": 0}, scrapeErrors(md))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	release <- struct{}{}
+
+	require.Eventually(t, func() bool {
+		md, err := r.ScrapeMetrics(t.Context())
+		return err == nil && slices.Equal([]string{"application-logs", "default"}, scrapedObjects(md))
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestScrapeWithLeaderElection_WatchMode(t *testing.T) {
+	fakeLeaderElection := &k8sleaderelectortest.FakeLeaderElection{}
+	leaderElectorID := component.MustNewID("k8s_leader_elector")
+	fakeHost := &k8sleaderelectortest.FakeHost{
+		FakeLeaderElection: fakeLeaderElection,
+	}
+
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	scheme := runtime.NewScheme()
+
+	telemetry := newUnstructuredObject("Telemetry", "telemetry", "default")
+	unstructured.SetNestedMap(telemetry, map[string]any{
+		"state": "Ready",
+	}, "status")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		}, &unstructured.Unstructured{
+			Object: telemetry,
+		},
+	)
+
+	s, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+			K8sLeaderElector:     &leaderElectorID,
+			Mode:                 ModeWatch,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type))
+	require.NoError(t, err)
+
+	require.NoError(t, s.Start(t.Context(), fakeHost))
+	defer func() {
+		require.NoError(t, s.Shutdown(t.Context()))
+	}()
+
+	// no watches before being a leader
+	require.Empty(t, dynamic.Actions())
+
+	fakeLeaderElection.InvokeOnLeading()
+
+	require.Eventually(t, func() bool {
+		md, err := s.ScrapeMetrics(t.Context())
+		return err == nil && md.DataPointCount() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	fakeLeaderElection.InvokeOnStopping()
+
+	md, err := s.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Zero(t, md.DataPointCount())
+}
+
+func TestScrape_NamespacesAndSelectors(t *testing.T) {
+	tests := []struct {
+		name          string
+		namespaces    NamespacesConfig
+		labelSelector string
+		expectedNames []string
+	}{
+		{
+			name:          "cluster-wide",
+			expectedNames: []string{"a", "b", "c"},
+		},
+		{
+			name:          "include namespaces",
+			namespaces:    NamespacesConfig{Include: []string{"ns-a", "ns-c"}},
+			expectedNames: []string{"a", "c"},
+		},
+		{
+			name:          "exclude namespaces",
+			namespaces:    NamespacesConfig{Exclude: []string{"ns-a"}},
+			expectedNames: []string{"b", "c"},
+		},
+		{
+			name:          "label selector",
+			labelSelector: "team=observability",
+			expectedNames: []string{"a", "b"},
+		},
+		{
+			name:          "include namespaces and label selector",
+			namespaces:    NamespacesConfig{Include: []string{"ns-b", "ns-c"}},
+			labelSelector: "team=observability",
+			expectedNames: []string{"b"},
+		},
+	}
+
+	for _, mode := range []Mode{ModePull, ModeWatch} {
+		for _, tt := range tests {
+			t.Run(string(mode)+"/"+tt.name, func(t *testing.T) {
+				resources := []ResourceConfig{
+					{
+						Group:         telemetryResourceGroup,
+						Version:       telemetryResourceVersion,
+						Resource:      "telemetries",
+						Namespaces:    tt.namespaces,
+						LabelSelector: tt.labelSelector,
+					},
+				}
+
+				dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+					map[schema.GroupVersionResource]string{
+						resources[0].gvr(): "TelemetryList",
+					},
+					newNamespacedTelemetry("a", "ns-a", map[string]any{"team": "observability"}),
+					newNamespacedTelemetry("b", "ns-b", map[string]any{"team": "observability"}),
+					newNamespacedTelemetry("c", "ns-c", nil),
+				)
+
+				r, err := newKymaScraper(
+					Config{
+						MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+						Resources:            resources,
+						Mode:                 mode,
+					},
+					dynamic,
+					newTestRESTMapper(),
+					receivertest.NewNopSettings(metadata.Type))
+				require.NoError(t, err)
+
+				require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+				defer func() {
+					require.NoError(t, r.Shutdown(t.Context()))
+				}()
+
+				var names []string
+
+				require.Eventually(t, func() bool {
+					md, err := r.ScrapeMetrics(t.Context())
+					if err != nil || md.ResourceMetrics().Len() == 0 {
+						return false
+					}
+
+					names = nil
+
+					for i := 0; i < md.ResourceMetrics().Len(); i++ {
+						name, _ := md.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.resource.name")
+						names = append(names, name.Str())
+					}
+
+					return true
+				}, 5*time.Second, 10*time.Millisecond)
+
+				require.ElementsMatch(t, tt.expectedNames, names)
+
+				// excluded namespaces are filtered by the API server
+				for _, action := range dynamic.Actions() {
+					if list, ok := action.(clienttesting.ListAction); ok {
+						require.Equal(t, resources[0].fieldSelector(), list.GetListRestrictions().Fields.String())
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestScrape_ConditionLastTransition(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	telemetry := newUnstructuredObject("Telemetry", "telemetry", "default")
+	unstructured.SetNestedMap(telemetry, map[string]any{
+		"state": "Ready",
+		"conditions": []any{
+			map[string]any{
+				"type":               "TelemetryHealthy",
+				"status":             "False",
+				"reason":             "ComponentsNotReady",
+				"message":            "Some components are not ready",
+				"lastTransitionTime": "2025-01-02T03:04:05Z",
+			},
+			map[string]any{
+				"type":               "LogComponentsHealthy",
+				"status":             "True",
+				"reason":             "ComponentsRunning",
+				"lastTransitionTime": "not a timestamp",
+			},
+		},
+	}, "status")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		&unstructured.Unstructured{Object: telemetry},
+	)
+
+	mbc := metadata.NewDefaultMetricsBuilderConfig()
+	mbc.Metrics.KymaResourceStatusConditionLastTransition.Enabled = true
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: mbc,
+			Resources:            resources,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+
+	var found bool
+
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		if m.Name() != "kyma.resource.status.condition.last_transition" {
+			continue
+		}
+
+		found = true
+
+		require.Equal(t, 1, m.Gauge().DataPoints().Len())
+		dp := m.Gauge().DataPoints().At(0)
+		require.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC).Unix(), dp.IntValue())
+
+		condType, _ := dp.Attributes().Get("type")
+		require.Equal(t, "TelemetryHealthy", condType.Str())
+
+		status, _ := dp.Attributes().Get("status")
+		require.Equal(t, "False", status.Str())
+	}
+
+	require.True(t, found)
+}
+
+func TestScrape_GenerationLag(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	newTelemetry := func(name string, generation int64, status map[string]any) *unstructured.Unstructured {
+		obj := newUnstructuredObject("Telemetry", "telemetry", name)
+		unstructured.SetNestedField(obj, generation, "metadata", "generation")
+		unstructured.SetNestedMap(obj, status, "status")
+
+		return &unstructured.Unstructured{Object: obj}
+	}
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		newTelemetry("reconciled", 3, map[string]any{"state": "Ready", "observedGeneration": int64(3)}),
+		newTelemetry("stuck", 5, map[string]any{"state": "Ready", "observedGeneration": int64(2)}),
+		newTelemetry("unknown", 1, map[string]any{"state": "Ready"}),
+	)
+
+	mbc := metadata.NewDefaultMetricsBuilderConfig()
+	mbc.Metrics.KymaResourceStatusGenerationLag.Enabled = true
+	mbc.Metrics.KymaResourceStatusReconciled.Enabled = true
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: mbc,
+			Resources:            resources,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+
+	values := make(map[string]int64)
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		metrics := md.ResourceMetrics().At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			m := metrics.At(j)
+			if m.Name() != "kyma.resource.status.generation_lag" && m.Name() != "kyma.resource.status.reconciled" {
+				continue
+			}
+
+			for k := 0; k < m.Gauge().DataPoints().Len(); k++ {
+				dp := m.Gauge().DataPoints().At(k)
+				name, _ := dp.Attributes().Get("name")
+				values[m.Name()+"/"+name.Str()] = dp.IntValue()
+			}
+		}
+	}
+
+	require.Equal(t, map[string]int64{
+		"kyma.resource.status.generation_lag/reconciled": 0,
+		"kyma.resource.status.reconciled/reconciled":     1,
+		"kyma.resource.status.generation_lag/stuck":      3,
+		"kyma.resource.status.reconciled/stuck":          0,
+	}, values)
+}
+
+func TestScrape_DeletionPending(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	deleting := newUnstructuredObject("Telemetry", "telemetry", "deleting")
+	unstructured.SetNestedField(deleting, "Deleting", "status", "state")
+	unstructured.SetNestedField(deleting, time.Now().Add(-time.Hour).UTC().Format(time.RFC3339), "metadata", "deletionTimestamp")
+	unstructured.SetNestedStringSlice(deleting, []string{"operator.kyma-project.io/telemetry", "operator.kyma-project.io/logpipelines"}, "metadata", "finalizers")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+		&unstructured.Unstructured{Object: deleting},
+	)
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+
+	var dps []pmetric.NumberDataPoint
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		metrics := md.ResourceMetrics().At(i).ScopeMetrics().At(0).Metrics()
+		for j := 0; j < metrics.Len(); j++ {
+			m := metrics.At(j)
+			if m.Name() != "kyma.resource.deletion.pending" {
+				continue
+			}
+
+			for k := 0; k < m.Gauge().DataPoints().Len(); k++ {
+				dps = append(dps, m.Gauge().DataPoints().At(k))
+			}
+		}
+	}
+
+	require.Len(t, dps, 1)
+
+	name, _ := dps[0].Attributes().Get("name")
+	require.Equal(t, "deleting", name.Str())
+
+	finalizers, _ := dps[0].Attributes().Get("finalizers")
+	require.Equal(t, "operator.kyma-project.io/telemetry,operator.kyma-project.io/logpipelines", finalizers.Str())
+
+	require.InDelta(t, time.Hour.Seconds(), dps[0].IntValue(), 60)
+}
+
+func TestScrape_StateSet(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	newTelemetry := func(name, state string) *unstructured.Unstructured {
+		obj := newUnstructuredObject("Telemetry", "telemetry", name)
+		unstructured.SetNestedField(obj, state, "status", "state")
+
+		return &unstructured.Unstructured{Object: obj}
+	}
+
+	tests := []struct {
+		name     string
+		stateSet StateSetConfig
+		expected map[string]int64
+	}{
+		{
+			name:     "disabled",
+			stateSet: StateSetConfig{States: defaultStates},
+			expected: map[string]int64{
+				"ready/Ready":      1,
+				"custom/Upgrading": 1,
+			},
+		},
+		{
+			name:     "enabled",
+			stateSet: StateSetConfig{Enabled: true, States: []string{"Ready", "Error"}},
+			expected: map[string]int64{
+				"ready/Ready":      1,
+				"ready/Error":      0,
+				"custom/Upgrading": 1,
+				"custom/Ready":     0,
+				"custom/Error":     0,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					resources[0].gvr(): "TelemetryList",
+				},
+				newTelemetry("ready", "Ready"),
+				newTelemetry("custom", "Upgrading"),
+			)
+
+			r, err := newKymaScraper(
+				Config{
+					MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+					Resources:            resources,
+					StateSet:             tt.stateSet,
+				},
+				dynamic,
+				newTestRESTMapper(),
+				receivertest.NewNopSettings(metadata.Type),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+			md, err := r.ScrapeMetrics(t.Context())
+			require.NoError(t, err)
+
+			values := make(map[string]int64)
+
+			for i := 0; i < md.ResourceMetrics().Len(); i++ {
+				metrics := md.ResourceMetrics().At(i).ScopeMetrics().At(0).Metrics()
+				for j := 0; j < metrics.Len(); j++ {
+					m := metrics.At(j)
+					if m.Name() != "kyma.resource.status.state" {
+						continue
+					}
+
+					for k := 0; k < m.Gauge().DataPoints().Len(); k++ {
+						dp := m.Gauge().DataPoints().At(k)
+						name, _ := dp.Attributes().Get("name")
+						state, _ := dp.Attributes().Get("state")
+						values[name.Str()+"/"+state.Str()] = dp.IntValue()
+					}
+				}
+			}
+
+			require.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func TestScrape_Fields(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "kymas",
+			Fields: []FieldConfig{
+				{
+					Path:   "status.modules[*].state",
+					Metric: "kyma.module.state",
+					Type:   FieldTypeStateSet,
+					States: []string{"Ready", "Error"},
+					Attributes: []FieldAttributeConfig{
+						{Name: "module", Path: "name"},
+					},
+				},
+				{
+					Path:   "spec.replicas",
+					Metric: "kyma.resource.replicas",
+					Unit:   "{replica}",
+					Type:   FieldTypeGauge,
+				},
+				{
+					Path:   "status.modules[*].name",
+					Metric: "kyma.module.invalid",
+					Type:   FieldTypeGauge,
+				},
+			},
+		},
+	}
+
+	kyma := newUnstructuredObject("Kyma", "telemetry", "default")
+	unstructured.SetNestedField(kyma, int64(2), "spec", "replicas")
+	unstructured.SetNestedMap(kyma, map[string]any{
+		"state": "Ready",
+		"modules": []any{
+			map[string]any{"name": "telemetry", "state": "Ready"},
+			map[string]any{"name": "istio", "state": "Error"},
+		},
+	}, "status")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "KymaList",
+		},
+		&unstructured.Unstructured{Object: kyma},
+	)
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	values := make(map[string]float64)
+
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		if m.Name() != "kyma.module.state" && m.Name() != "kyma.resource.replicas" {
+			continue
+		}
+
+		for j := 0; j < m.Gauge().DataPoints().Len(); j++ {
+			dp := m.Gauge().DataPoints().At(j)
+
+			name, _ := dp.Attributes().Get("name")
+			require.Equal(t, "default", name.Str())
+
+			key := m.Name()
+			if module, ok := dp.Attributes().Get("module"); ok {
+				state, _ := dp.Attributes().Get("state")
+				key += "/" + module.Str() + "/" + state.Str()
+			}
+
+			values[key] = dp.DoubleValue()
+		}
+	}
+
+	require.Equal(t, map[string]float64{
+		"kyma.module.state/telemetry/Ready": 1,
+		"kyma.module.state/telemetry/Error": 0,
+		"kyma.module.state/istio/Error":     1,
+		"kyma.module.state/istio/Ready":     0,
+		"kyma.resource.replicas":            2,
+	}, values)
+
+	for i := 0; i < metrics.Len(); i++ {
+		require.NotEqual(t, "kyma.module.invalid", metrics.At(i).Name())
+	}
+}
+
+func TestFieldExtractorCache(t *testing.T) {
+	replicas := FieldConfig{Path: "spec.replicas", Metric: "kyma.resource.replicas", Type: FieldTypeGauge}
+	ready := FieldConfig{Path: "status.readyReplicas", Metric: "kyma.resource.ready_replicas", Type: FieldTypeGauge}
+
+	telemetries := ResourceConfig{Group: telemetryResourceGroup, Version: telemetryResourceVersion, Resource: "telemetries", Fields: []FieldConfig{replicas}}
+	logPipelines := ResourceConfig{Group: logPipelineResourceGroup, Version: logPipelineResourceVersion, Resource: "logpipelines"}
+
+	var cache fieldExtractorCache
+
+	first, err := cache.get([]ResourceConfig{telemetries, logPipelines})
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	require.Len(t, first[0], 1)
+	require.Empty(t, first[1])
+
+	// unchanged fields reuse the parsed extractors
+	second, err := cache.get([]ResourceConfig{logPipelines, telemetries})
+	require.NoError(t, err)
+	require.True(t, &first[0][0] == &second[1][0])
+
+	// changed fields are parsed again
+	telemetries.Fields = []FieldConfig{replicas, ready}
+
+	third, err := cache.get([]ResourceConfig{telemetries})
+	require.NoError(t, err)
+	require.Len(t, third[0], 2)
+	require.False(t, &first[0][0] == &third[0][0])
+	require.Len(t, cache.entries, 1)
+
+	// the same resource configured twice keeps the extractors of both entries
+	defaultTelemetries := telemetries
+	defaultTelemetries.Namespaces = NamespacesConfig{Include: []string{"default"}}
+	defaultTelemetries.Fields = []FieldConfig{ready}
+
+	fourth, err := cache.get([]ResourceConfig{telemetries, defaultTelemetries})
+	require.NoError(t, err)
+	require.True(t, &third[0][0] == &fourth[0][0])
+	require.Len(t, fourth[1], 1)
+	require.Equal(t, ready.Path, fourth[1][0].config.Path)
+
+	fifth, err := cache.get([]ResourceConfig{telemetries, defaultTelemetries})
+	require.NoError(t, err)
+	require.True(t, &fourth[0][0] == &fifth[0][0])
+	require.True(t, &fourth[1][0] == &fifth[1][0])
+
+	_, err = cache.get([]ResourceConfig{{Resource: "invalid", Fields: []FieldConfig{{Path: "status.conditions[x]"}}}})
+	require.Error(t, err)
+}
+
+func TestScrape_KymaModules(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "kymas",
+		},
+	}
+
+	kyma := newUnstructuredObject("Kyma", "telemetry", "default")
+	unstructured.SetNestedMap(kyma, map[string]any{
+		"state": "Warning",
+		"modules": []any{
+			map[string]any{
+				"name":    "telemetry",
+				"fqdn":    "kyma-project.io/module/telemetry",
+				"channel": "regular",
+				"version": "1.40.0",
+				"state":   "Ready",
+			},
+			map[string]any{
+				"name":    "istio",
+				"fqdn":    "kyma-project.io/module/istio",
+				"channel": "fast",
+				"version": "1.12.0",
+				"state":   "Warning",
+			},
+			map[string]any{
+				"state": "Error",
+			},
+		},
+	}, "status")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "KymaList",
+		},
+		&unstructured.Unstructured{Object: kyma},
+	)
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+
+	var modules []map[string]any
+
+	for i := 0; i < metrics.Len(); i++ {
+		m := metrics.At(i)
+		if m.Name() != "kyma.module.status.state" {
+			continue
+		}
+
+		for j := 0; j < m.Gauge().DataPoints().Len(); j++ {
+			dp := m.Gauge().DataPoints().At(j)
+			require.Equal(t, int64(1), dp.IntValue())
+
+			modules = append(modules, dp.Attributes().AsRaw())
+		}
+	}
+
+	require.ElementsMatch(t, []map[string]any{
+		{
+			"group":          telemetryResourceGroup,
+			"version":        telemetryResourceVersion,
+			"kind":           "Kyma",
+			"name":           "default",
+			"namespace":      telemetryResourceNamespace,
+			"module":         "telemetry",
+			"fqdn":           "kyma-project.io/module/telemetry",
+			"channel":        "regular",
+			"module_version": "1.40.0",
+			"state":          "Ready",
+		},
+		{
+			"group":          telemetryResourceGroup,
+			"version":        telemetryResourceVersion,
+			"kind":           "Kyma",
+			"name":           "default",
+			"namespace":      telemetryResourceNamespace,
+			"module":         "istio",
+			"fqdn":           "kyma-project.io/module/istio",
+			"channel":        "fast",
+			"module_version": "1.12.0",
+			"state":          "Warning",
+		},
+	}, modules)
+}
+
+func TestScrape_LabelsAndAnnotations(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	telemetry := newNamespacedTelemetry("default", telemetryResourceNamespace, map[string]any{
+		"app.kubernetes.io/name":    "telemetry",
+		"app.kubernetes.io/part-of": "kyma",
+		"team":                      "observability",
+	})
+	telemetry.SetAnnotations(map[string]string{
+		"operator.kyma-project.io/managed-by": "lifecycle-manager",
+		"kubectl.kubernetes.io/last-applied":  "{}",
+	})
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		telemetry,
+	)
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+			Labels:               []string{"app.kubernetes.io/*"},
+			Annotations:          []string{"operator.kyma-project.io/managed-by"},
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+
+	require.Equal(t, map[string]any{
+		"k8s.namespace.name":                                          telemetryResourceNamespace,
+		"k8s.resource.name":                                           "default",
+		"k8s.resource.group":                                          telemetryResourceGroup,
+		"k8s.resource.version":                                        telemetryResourceVersion,
+		"k8s.resource.kind":                                           "Telemetry",
+		"k8s.resource.plural":                                         "telemetries",
+		"k8s.resource.label.app.kubernetes.io/name":                   "telemetry",
+		"k8s.resource.label.app.kubernetes.io/part-of":                "kyma",
+		"k8s.resource.annotation.operator.kyma-project.io/managed-by": "lifecycle-manager",
+	}, md.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+}
+
+func TestScrape_Owners(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	apiVersion := telemetryResourceGroup + "/" + telemetryResourceVersion
+
+	newOwned := func(kind, name, uid string, owners ...metav1.OwnerReference) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: newUnstructuredObject(kind, "telemetry", name)}
+		obj.SetUID(types.UID(uid))
+		obj.SetOwnerReferences(owners)
+		unstructured.SetNestedField(obj.Object, "Ready", "status", "state")
+
+		return obj
+	}
+
+	controllerRef := func(kind, name, uid string) metav1.OwnerReference {
+		return metav1.OwnerReference{APIVersion: apiVersion, Kind: kind, Name: name, UID: types.UID(uid), Controller: ptr.To(true)}
+	}
+
+	kyma := newOwned("Kyma", "default", "kyma-uid")
+	manifest := newOwned("Manifest", "telemetry-manifest", "manifest-uid", controllerRef("Kyma", "default", "kyma-uid"))
+	telemetry := newOwned("Telemetry", "default", "telemetry-uid",
+		metav1.OwnerReference{APIVersion: apiVersion, Kind: "Kyma", Name: "default", UID: "kyma-uid"},
+		controllerRef("Manifest", "telemetry-manifest", "manifest-uid"),
+	)
+	orphan := newOwned("Telemetry", "orphan", "orphan-uid")
+	lostOwner := newOwned("Telemetry", "lost-owner", "lost-owner-uid", controllerRef("Manifest", "deleted-manifest", "deleted-uid"))
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		kyma, manifest, telemetry, orphan, lostOwner,
+	)
+
+	mbc := newResourceMetricsBuilderConfig()
+	mbc.ResourceAttributes.K8sResourceOwnerKind.Enabled = true
+	mbc.ResourceAttributes.K8sResourceOwnerName.Enabled = true
+	mbc.ResourceAttributes.K8sResourceOwnerUID.Enabled = true
+	mbc.ResourceAttributes.K8sResourceTopOwnerKind.Enabled = true
+	mbc.ResourceAttributes.K8sResourceTopOwnerName.Enabled = true
+	mbc.ResourceAttributes.K8sResourceTopOwnerUID.Enabled = true
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: mbc,
+			Resources:            resources,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+
+	owners := make(map[string]map[string]any)
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		attrs := md.ResourceMetrics().At(i).Resource().Attributes().AsRaw()
+		name := attrs["k8s.resource.name"].(string)
+
+		for k := range attrs {
+			if !strings.Contains(k, "owner") {
+				delete(attrs, k)
+			}
+		}
+
+		owners[name] = attrs
+	}
+
+	require.Equal(t, map[string]map[string]any{
+		"default": {
+			"k8s.resource.owner.kind":     "Manifest",
+			"k8s.resource.owner.name":     "telemetry-manifest",
+			"k8s.resource.owner.uid":      "manifest-uid",
+			"k8s.resource.top_owner.kind": "Kyma",
+			"k8s.resource.top_owner.name": "default",
+			"k8s.resource.top_owner.uid":  "kyma-uid",
+		},
+		"orphan": {},
+		"lost-owner": {
+			"k8s.resource.owner.kind":     "Manifest",
+			"k8s.resource.owner.name":     "deleted-manifest",
+			"k8s.resource.owner.uid":      "deleted-uid",
+			"k8s.resource.top_owner.kind": "Manifest",
+			"k8s.resource.top_owner.name": "deleted-manifest",
+			"k8s.resource.top_owner.uid":  "deleted-uid",
+		},
+	}, owners)
+}
+
+func TestScrape_ClusterIdentity(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	kubeSystem := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata": map[string]any{
+			"name": "kube-system",
+			"uid":  "cluster-uid",
+		},
+	}}
+
+	shootInfo := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"namespace": "kyma-system",
+			"name":      "shoot-info",
+		},
+		"data": map[string]any{
+			"shootName": "shoot-cluster",
+		},
+	}}
+
+	shootInfoRef := &ConfigMapKeyConfig{Namespace: "kyma-system", Name: "shoot-info", Key: "shootName"}
+
+	tests := []struct {
+		name        string
+		objects     []runtime.Object
+		clusterName ClusterNameConfig
+		expected    map[string]any
+	}{
+		{
+			name:        "config map",
+			objects:     []runtime.Object{kubeSystem, shootInfo},
+			clusterName: ClusterNameConfig{ConfigMap: shootInfoRef},
+			expected: map[string]any{
+				"k8s.cluster.uid":  "cluster-uid",
+				"k8s.cluster.name": "shoot-cluster",
+			},
+		},
+		{
+			name:        "static value",
+			objects:     []runtime.Object{kubeSystem},
+			clusterName: ClusterNameConfig{Value: "static-cluster"},
+			expected: map[string]any{
+				"k8s.cluster.uid":  "cluster-uid",
+				"k8s.cluster.name": "static-cluster",
+			},
+		},
+		{
+			name:        "unresolvable",
+			clusterName: ClusterNameConfig{ConfigMap: shootInfoRef},
+			expected:    map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			telemetry := &unstructured.Unstructured{Object: newUnstructuredObject("Telemetry", "telemetry", "default")}
+			unstructured.SetNestedField(telemetry.Object, "Ready", "status", "state")
+
+			dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{
+					resources[0].gvr(): "TelemetryList",
+				},
+				append(tt.objects, telemetry)...,
+			)
+
+			mbc := metadata.NewDefaultMetricsBuilderConfig()
+			mbc.ResourceAttributes.K8sClusterUID.Enabled = true
+			mbc.ResourceAttributes.K8sClusterName.Enabled = true
+
+			r, err := newKymaScraper(
+				Config{
+					MetricsBuilderConfig: mbc,
+					Resources:            resources,
+					ClusterName:          tt.clusterName,
+				},
+				dynamic,
+				newTestRESTMapper(),
+				receivertest.NewNopSettings(metadata.Type),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+			md, err := r.ScrapeMetrics(t.Context())
+			require.NoError(t, err)
+			require.Equal(t, 2, md.ResourceMetrics().Len())
+
+			// the cluster identity is attached to the resources of the per-object and the aggregated metrics
+			for i := 0; i < md.ResourceMetrics().Len(); i++ {
+				attrs := md.ResourceMetrics().At(i).Resource().Attributes().AsRaw()
+
+				for k := range attrs {
+					if !strings.HasPrefix(k, "k8s.cluster.") {
+						delete(attrs, k)
+					}
+				}
+
+				require.Equal(t, tt.expected, attrs)
+			}
+		})
+	}
+}
+
+func TestScrape_Sharding(t *testing.T) {
+	const replicas = 3
+
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	var objects []runtime.Object
+
+	for i := range 30 {
+		obj := newNamespacedTelemetry(fmt.Sprintf("telemetry-%d", i), fmt.Sprintf("namespace-%d", i%5), nil)
+		obj.SetUID(types.UID(fmt.Sprintf("uid-%d", i)))
+		objects = append(objects, obj)
+	}
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		objects...,
+	)
+
+	collected := make(map[string]int)
+
+	for ordinal := range replicas {
+		r, err := newKymaScraper(
+			Config{
+				MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+				Resources:            resources,
+				Sharding: ShardingConfig{
+					Replicas: replicas,
+					Ordinal:  ptr.To(ordinal),
+					Key:      ShardingKeyUID,
+				},
+			},
+			dynamic,
+			newTestRESTMapper(),
+			receivertest.NewNopSettings(metadata.Type),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+		md, err := r.ScrapeMetrics(t.Context())
+		require.NoError(t, err)
+
+		// every replica collects a share of the objects
+		require.Positive(t, md.ResourceMetrics().Len())
+
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			name, ok := md.ResourceMetrics().At(i).Resource().Attributes().Get("k8s.resource.name")
+			require.True(t, ok)
+
+			collected[name.Str()]++
+		}
+	}
+
+	// every object is collected by exactly one replica
+	require.Len(t, collected, len(objects))
+
+	for name, count := range collected {
+		require.Equal(t, 1, count, "resource %s", name)
+	}
+}
+
+func TestScrape_ShardingAggregatedMetrics(t *testing.T) {
+	const replicas = 2
+
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	var objects []runtime.Object
+
+	for i := range 10 {
+		obj := newNamespacedTelemetry(fmt.Sprintf("telemetry-%d", i), telemetryResourceNamespace, nil)
+		obj.SetUID(types.UID(fmt.Sprintf("uid-%d", i)))
+		objects = append(objects, obj)
+	}
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		objects...,
+	)
+
+	// series identifies a series by its resource attributes, metric name, and data point attributes
+	series := make(map[string]int)
+
+	for ordinal := range replicas {
+		r, err := newKymaScraper(
+			Config{
+				MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+				Resources:            resources,
+				Sharding: ShardingConfig{
+					Replicas: replicas,
+					Ordinal:  ptr.To(ordinal),
+					Key:      ShardingKeyUID,
+				},
+			},
+			dynamic,
+			newTestRESTMapper(),
+			receivertest.NewNopSettings(metadata.Type),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+		md, err := r.ScrapeMetrics(t.Context())
+		require.NoError(t, err)
+
+		aggregated := 0
+
+		for i := 0; i < md.ResourceMetrics().Len(); i++ {
+			rm := md.ResourceMetrics().At(i)
+			if _, ok := rm.Resource().Attributes().Get("k8s.resource.name"); ok {
+				continue
+			}
+
+			aggregated++
+
+			shard, ok := rm.Resource().Attributes().Get("kyma.receiver.shard")
+			require.True(t, ok)
+			require.Equal(t, strconv.Itoa(ordinal), shard.Str())
+
+			ms := rm.ScopeMetrics().At(0).Metrics()
+			for j := 0; j < ms.Len(); j++ {
+				dps := ms.At(j).Gauge().DataPoints()
+				for k := 0; k < dps.Len(); k++ {
+					key := fmt.Sprint(rm.Resource().Attributes().AsRaw(), ms.At(j).Name(), dps.At(k).Attributes().AsRaw())
+					series[key]++
+				}
+			}
+		}
+
+		require.Equal(t, 1, aggregated)
+	}
+
+	// the aggregated metrics of the replicas don't collide
+	require.NotEmpty(t, series)
+
+	for key, count := range series {
+		require.Equal(t, 1, count, "series %s", key)
+	}
+}
+
+func TestScrape_ConditionMessage(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	obj := newUnstructuredObject("Telemetry", "telemetry", "default")
+	unstructured.SetNestedField(obj, "Error", "status", "state")
+	unstructured.SetNestedSlice(obj, []any{
+		map[string]any{"type": "LogComponentsHealthy", "status": "True", "reason": "AgentReady", "message": "Log agent is ready"},
+		map[string]any{"type": "MetricComponentsHealthy", "status": "False", "reason": "GatewayNotReady", "message": "Pod otel-gateway-7d4b9c-x2x8k is not ready: container otel-collector is crash looping"},
+		map[string]any{"type": "TraceComponentsHealthy", "status": "Unknown"},
+	}, "status", "conditions")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		&unstructured.Unstructured{Object: obj},
+	)
+
+	mbc := newResourceMetricsBuilderConfig()
+	mbc.Metrics.KymaResourceStatusState.Enabled = false
+	mbc.Metrics.KymaResourceStatusConditions.EnabledAttributes = append(mbc.Metrics.KymaResourceStatusConditions.EnabledAttributes,
+		metadata.KymaResourceStatusConditionsMetricAttributeKeyMessage,
+	)
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: mbc,
+			Resources:            resources,
+			Conditions:           ConditionsConfig{MessageMaxLength: 20},
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+
+	type dataPoint struct {
+		reason  string
+		message string
+		value   int64
+	}
+
+	dps := metrics.At(0).Gauge().DataPoints()
+	got := make(map[string]dataPoint, dps.Len())
+
+	for i := 0; i < dps.Len(); i++ {
+		attrs := dps.At(i).Attributes().AsRaw()
+		got[attrs["type"].(string)] = dataPoint{
+			reason:  attrs["reason"].(string),
+			message: attrs["message"].(string),
+			value:   dps.At(i).IntValue(),
+		}
+	}
+
+	require.Equal(t, map[string]dataPoint{
+		"LogComponentsHealthy":    {reason: "AgentReady", message: "Log agent is ready", value: 1},
+		"MetricComponentsHealthy": {reason: "GatewayNotReady", message: truncateMessage("Pod otel-gateway-7d4b9c-x2x8k is not ready: container otel-collector is crash looping", 20), value: 0},
+		"TraceComponentsHealthy":  {reason: "", message: "", value: -1},
+	}, got)
+}
+
+func TestTruncateMessage(t *testing.T) {
+	require.Equal(t, "short", truncateMessage("short", 5))
+	require.Equal(t, "", truncateMessage("", 5))
+
+	long := truncateMessage("a message that is too long", 9)
+	require.True(t, strings.HasPrefix(long, "a message..."), long)
+	require.Len(t, long, len("a message...")+8)
+
+	// messages with the same prefix stay distinguishable
+	require.NotEqual(t, long, truncateMessage("a message that is different", 9))
+
+	// multi-byte characters are not split
+	require.True(t, strings.HasPrefix(truncateMessage("Zustand: ungültig, Komponente fehlt", 17), "Zustand: ungültig..."))
+}
+
+func TestScrape_KindFallback(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+	)
+
+	// the resource is unknown to the mapper, so the kind is taken from the object
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+		},
+		dynamic,
+		meta.NewDefaultRESTMapper(nil),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+
+	attrs := md.ResourceMetrics().At(0).Resource().Attributes()
+
+	kind, ok := attrs.Get("k8s.resource.kind")
+	require.True(t, ok)
+	require.Equal(t, "Telemetry", kind.Str())
+
+	plural, ok := attrs.Get("k8s.resource.plural")
+	require.True(t, ok)
+	require.Equal(t, "telemetries", plural.Str())
+}
+
+func TestScrape_Counts(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	newTelemetry := func(name, namespace, state, healthy string) *unstructured.Unstructured {
+		obj := newNamespacedTelemetry(name, namespace, nil)
+		unstructured.SetNestedField(obj.Object, state, "status", "state")
+		unstructured.SetNestedSlice(obj.Object, []any{
+			map[string]any{
+				"type":   "TelemetryHealthy",
+				"status": healthy,
+				"reason": "Reason",
+			},
+		}, "status", "conditions")
+
+		return obj
+	}
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		newTelemetry("a", "ns-a", "Ready", "True"),
+		newTelemetry("b", "ns-a", "Ready", "True"),
+		newTelemetry("c", "ns-a", "Error", "False"),
+		newTelemetry("d", "ns-b", "Ready", "True"),
+	)
+
+	// per-object metrics can be disabled independently of the aggregated counts
+	mbc := metadata.NewDefaultMetricsBuilderConfig()
+	mbc.Metrics.KymaResourceStatusState.Enabled = false
+	mbc.Metrics.KymaResourceStatusConditions.Enabled = false
+	mbc.Metrics.KymaResourceScrapeErrors.Enabled = false
+	mbc.Metrics.KymaResourceConditionCount.Enabled = true
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: mbc,
+			Resources:            resources,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, md.ResourceMetrics().Len())
+	require.Zero(t, md.ResourceMetrics().At(0).Resource().Attributes().Len())
+
+	counts := make(map[string]int64)
+
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		dps := metrics.At(i).Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			attrs := dps.At(j).Attributes()
+			namespace, _ := attrs.Get("namespace")
+
+			key := metrics.At(i).Name() + "/" + namespace.Str()
+			if state, ok := attrs.Get("state"); ok {
+				key += "/" + state.Str()
+			}
+
+			if status, ok := attrs.Get("status"); ok {
+				key += "/" + status.Str()
+			}
+
+			counts[key] = dps.At(j).IntValue()
+		}
+	}
+
+	require.Equal(t, map[string]int64{
+		"kyma.resource.count/ns-a/Ready":           2,
+		"kyma.resource.count/ns-a/Error":           1,
+		"kyma.resource.count/ns-b/Ready":           1,
+		"kyma.resource.condition.count/ns-a/True":  2,
+		"kyma.resource.condition.count/ns-a/False": 1,
+		"kyma.resource.condition.count/ns-b/True":  1,
+	}, counts)
+}
+
+func TestScrape_Pagination(t *testing.T) {
+	// the fake client only passes limit and continue of namespaced list requests to reactors
+	resources := []ResourceConfig{
+		{
+			Group:      telemetryResourceGroup,
+			Version:    telemetryResourceVersion,
+			Resource:   "telemetries",
+			Namespaces: NamespacesConfig{Include: []string{telemetryResourceNamespace}},
+		},
+	}
+
+	var items []unstructured.Unstructured
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		items = append(items, *newNamespacedTelemetry(name, telemetryResourceNamespace, nil))
+	}
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+	)
+
+	var limits []int64
+
+	dynamic.PrependReactor("list", "telemetries", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		opts := action.(clienttesting.ListActionImpl).ListOptions
+		limits = append(limits, opts.Limit)
+
+		start := 0
+		if opts.Continue != "" {
+			start, _ = strconv.Atoi(opts.Continue)
+		}
+
+		end := len(items)
+		if opts.Limit > 0 {
+			end = min(start+int(opts.Limit), len(items))
+		}
+
+		list := &unstructured.UnstructuredList{Items: items[start:end]}
+		if end < len(items) {
+			list.SetContinue(strconv.Itoa(end))
+		}
+
+		return true, list, nil
+	})
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+			PageSize:             2,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	md, err := r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 5, md.ResourceMetrics().Len())
+	require.Equal(t, []int64{2, 2, 2}, limits)
+}
+
+func TestScrape_ConcurrentListing(t *testing.T) {
+	var resources []ResourceConfig
+
+	listKinds := make(map[schema.GroupVersionResource]string)
+
+	for i := range 6 {
+		resource := ResourceConfig{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "resources" + strconv.Itoa(i),
+		}
+		resources = append(resources, resource)
+		listKinds[resource.gvr()] = "ResourceList"
+	}
+
+	dynamic := &concurrencyTrackingClient{
+		Interface: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds),
+	}
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig:  newResourceMetricsBuilderConfig(),
+			Resources:             resources,
+			MaxConcurrentRequests: 2,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	_, err = r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+
+	require.Equal(t, int32(6), dynamic.lists.Load())
+	require.Equal(t, int32(2), dynamic.maxInFlight.Load())
+}
+
+func TestScrape_ContextDone(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+		{
+			Group:    logPipelineResourceGroup,
+			Version:  logPipelineResourceVersion,
+			Resource: "logpipelines",
+		},
+	}
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+			resources[1].gvr(): "LogPipelineList",
+		},
+		newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+	)
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig:  newResourceMetricsBuilderConfig(),
+			Resources:             resources,
+			MaxConcurrentRequests: 1,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	md, err := r.ScrapeMetrics(ctx)
+	require.Error(t, err)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.ErrorContains(t, err, context.Canceled.Error())
+	require.Equal(t, 0, md.ResourceMetrics().Len())
+	require.Equal(t, 0, countListActions(dynamic))
+}
+
+func TestScrape_InternalTelemetry(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	noStatus := newUnstructuredObject("Telemetry", "telemetry", "no-status")
+
+	noConditionStatus := newUnstructuredObject("Telemetry", "telemetry", "no-condition-status")
+	unstructured.SetNestedField(noConditionStatus, "Ready", "status", "state")
+	unstructured.SetNestedSlice(noConditionStatus, []any{
+		map[string]any{"type": "Healthy", "reason": "Running"},
+	}, "status", "conditions")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		newNamespacedTelemetry("default", telemetryResourceNamespace, nil),
+		&unstructured.Unstructured{Object: noStatus},
+		&unstructured.Unstructured{Object: noConditionStatus},
+	)
+
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+
+	settings := receivertest.NewNopSettings(metadata.Type)
+	settings.TelemetrySettings = tel.NewTelemetrySettings()
+
+	r, err := newKymaScraper(
+		Config{
+			MetricsBuilderConfig: newResourceMetricsBuilderConfig(),
+			Resources:            resources,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		settings,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	_, err = r.ScrapeMetrics(t.Context())
+	require.NoError(t, err)
+
+	gvrAttrs := attribute.NewSet(
+		attribute.String("group", telemetryResourceGroup),
+		attribute.String("version", telemetryResourceVersion),
+		attribute.String("resource", "telemetries"),
+	)
+
+	metadatatest.AssertEqualKymastatsLeader(t, tel,
+		[]metricdata.DataPoint[int64]{{Value: 1}},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualKymastatsListedObjects(t, tel,
+		[]metricdata.DataPoint[int64]{{Attributes: gvrAttrs, Value: 3}},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualKymastatsListDuration(t, tel,
+		[]metricdata.HistogramDataPoint[float64]{{Attributes: gvrAttrs}},
+		metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreValue())
+	metadatatest.AssertEqualKymastatsConversionFailures(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Attributes: attribute.NewSet(attribute.String("reason", "resource_status_not_found")), Value: 1},
+			{Attributes: attribute.NewSet(attribute.String("reason", "condition_status_not_found")), Value: 1},
+		},
+		metricdatatest.IgnoreTimestamp())
+
+	require.NoError(t, r.Shutdown(t.Context()))
+}
+
+// newTestRESTMapper returns a REST mapper knowing the kinds of all resources used in tests.
+func newTestRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+
+	for _, gvk := range []schema.GroupVersionKind{
+		{Group: telemetryResourceGroup, Version: telemetryResourceVersion, Kind: "Telemetry"},
+		{Group: telemetryResourceGroup, Version: telemetryResourceVersion, Kind: "Kyma"},
+		{Group: telemetryResourceGroup, Version: telemetryResourceVersion, Kind: "Manifest"},
+		{Group: telemetryResourceGroup, Version: telemetryResourceVersion, Kind: "MyKymaResource"},
+		{Group: logPipelineResourceGroup, Version: logPipelineResourceVersion, Kind: "LogPipeline"},
+	} {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+
+	return mapper
+}
+
+// newResourceMetricsBuilderConfig returns the default metrics config without the scrape errors and count metrics,
+// which are not reported per scraped object.
+func newResourceMetricsBuilderConfig() metadata.MetricsBuilderConfig {
+	mbc := metadata.NewDefaultMetricsBuilderConfig()
+	mbc.Metrics.KymaResourceScrapeErrors.Enabled = false
+	mbc.Metrics.KymaResourceCount.Enabled = false
+
+	return mbc
+}
+
+func newNamespacedTelemetry(name, namespace string, labels map[string]any) *unstructured.Unstructured {
+	obj := map[string]any{
+		"apiVersion": telemetryResourceGroup + "/" + telemetryResourceVersion,
+		"kind":       "Telemetry",
+		"metadata": map[string]any{
+			"namespace": namespace,
+			"name":      name,
+		},
+		"status": map[string]any{
+			"state": "Ready",
+		},
+	}
+
+	if labels != nil {
+		unstructured.SetNestedMap(obj, labels, "metadata", "labels")
+	}
+
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func countListActions(dynamic *dynamicfake.FakeDynamicClient) int {
+	count := 0
+
+	for _, action := range dynamic.Actions() {
+		if action.GetVerb() == "list" {
+			count++
+		}
+	}
+
+	return count
+}
+
+func newUnstructuredObject(kind, resourceType, name string) map[string]any {
+	if resourceType == "telemetry" {
+		return map[string]any{
+			"apiVersion": telemetryResourceGroup + "/" + telemetryResourceVersion,
+			"kind":       kind,
+			"metadata": map[string]any{
+				"namespace": telemetryResourceNamespace,
+				"name":      name,
+			},
+		}
+	}
+
+	if resourceType == "logpipeline" {
+		return map[string]any{
+			"apiVersion": logPipelineResourceGroup + "/" + logPipelineResourceVersion,
+			"kind":       kind,
+			"metadata": map[string]any{
+				"name": name,
+			},
+		}
+	}
+
+	return nil
+}
+
+// concurrencyTrackingClient counts the list requests, and how many of them are in flight at the same time.
+// Each list request is delayed, so that concurrent requests overlap.
+type concurrencyTrackingClient struct {
+	dynamic.Interface
+
+	lists       atomic.Int32
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (c *concurrencyTrackingClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &concurrencyTrackingResource{NamespaceableResourceInterface: c.Interface.Resource(gvr), client: c}
+}
+
+type concurrencyTrackingResource struct {
+	dynamic.NamespaceableResourceInterface
+
+	client *concurrencyTrackingClient
+}
+
+func (r *concurrencyTrackingResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &concurrencyTrackingNamespacedResource{ResourceInterface: r.NamespaceableResourceInterface.Namespace(namespace), client: r.client}
+}
+
+type concurrencyTrackingNamespacedResource struct {
+	dynamic.ResourceInterface
+
+	client *concurrencyTrackingClient
+}
+
+func (r *concurrencyTrackingNamespacedResource) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	r.client.lists.Add(1)
+
+	inFlight := r.client.inFlight.Add(1)
+	defer r.client.inFlight.Add(-1)
+
+	for {
+		current := r.client.maxInFlight.Load()
+		if inFlight <= current || r.client.maxInFlight.CompareAndSwap(current, inFlight) {
+			break
+		}
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	return r.ResourceInterface.List(ctx, opts)
 }
@@ -0,0 +1,122 @@
+package kymastatsreceiver
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// maxOwnerDepth limits how many controller owners are followed, which protects against owner reference cycles.
+const maxOwnerDepth = 10
+
+// owner identifies an owner of a resource object.
+type owner struct {
+	kind string
+	name string
+	uid  string
+}
+
+// controllerOwner returns the owner reference that is marked as the managing controller of the object.
+func controllerOwner(obj *unstructured.Unstructured) *metav1.OwnerReference {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Controller != nil && *ref.Controller {
+			return &ref
+		}
+	}
+
+	return nil
+}
+
+func newOwner(ref *metav1.OwnerReference) *owner {
+	return &owner{
+		kind: ref.Kind,
+		name: ref.Name,
+		uid:  string(ref.UID),
+	}
+}
+
+// ownerResolver follows the controller owners of resource objects up to the top-level owner. The top-level owners
+// are cached for the duration of a single scrape, as many objects usually share the same owners.
+type ownerResolver struct {
+	ks *kymaScraper
+
+	mu        sync.Mutex
+	topOwners map[types.UID]*owner
+}
+
+func newOwnerResolver(ks *kymaScraper) *ownerResolver {
+	return &ownerResolver{
+		ks:        ks,
+		topOwners: make(map[types.UID]*owner),
+	}
+}
+
+// topOwner returns the top-level owner of an object owned by ref. If an owner can't be retrieved, the last
+// known owner of the chain is returned.
+func (r *ownerResolver) topOwner(ctx context.Context, namespace string, ref *metav1.OwnerReference) *owner {
+	r.mu.Lock()
+	cached, ok := r.topOwners[ref.UID]
+	r.mu.Unlock()
+
+	if ok {
+		return cached
+	}
+
+	top := ref
+
+	for range maxOwnerDepth {
+		obj, err := r.get(ctx, namespace, top)
+		if err != nil {
+			r.ks.logger.Debug("Error retrieving owner, stopping at the last known owner",
+				zap.Error(err),
+				zap.String("kind", top.Kind),
+				zap.String("name", top.Name),
+				zap.String("namespace", namespace),
+			)
+
+			break
+		}
+
+		next := controllerOwner(obj)
+		if next == nil {
+			break
+		}
+
+		top = next
+	}
+
+	res := newOwner(top)
+
+	r.mu.Lock()
+	r.topOwners[ref.UID] = res
+	r.mu.Unlock()
+
+	return res
+}
+
+// get retrieves the owner object. Namespaced owners are always in the namespace of the owned object.
+func (r *ownerResolver) get(ctx context.Context, namespace string, ref *metav1.OwnerReference) (*unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := r.ks.mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: ref.Kind}, gv.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	client := r.ks.dynamic.Resource(mapping.Resource)
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return client.Namespace(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	}
+
+	return client.Get(ctx, ref.Name, metav1.GetOptions{})
+}
@@ -0,0 +1,210 @@
+package kymastatsreceiver
+
+import (
+	"sync"
+
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// resourceConfigMapData is the content of the ConfigMap key, which uses the same format as the receiver config.
+type resourceConfigMapData struct {
+	Resources []ResourceConfig `mapstructure:"resources"`
+}
+
+// resourceConfigMap watches a ConfigMap holding additional resources to collect, so that the collected resources
+// can be changed without restarting the collector. An invalid ConfigMap is rejected as a whole, and the previously
+// loaded resources are kept.
+type resourceConfigMap struct {
+	dynamic dynamic.Interface
+	config  ConfigMapKeyConfig
+	logger  *zap.Logger
+
+	mu           sync.Mutex
+	resources    []ResourceConfig
+	err          error
+	informer     cache.SharedIndexInformer
+	registration cache.ResourceEventHandlerRegistration
+	stopCh       chan struct{}
+	wg           sync.WaitGroup
+}
+
+func newResourceConfigMap(dynamic dynamic.Interface, config ConfigMapKeyConfig, logger *zap.Logger) *resourceConfigMap {
+	return &resourceConfigMap{
+		dynamic: dynamic,
+		config:  config,
+		logger:  logger,
+	}
+}
+
+// start starts watching the ConfigMap. Calling start on a running watch is a no-op.
+func (c *resourceConfigMap) start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.informer != nil {
+		return
+	}
+
+	c.informer = dynamicinformer.NewFilteredDynamicInformer(
+		c.dynamic,
+		configMapsGVR,
+		c.config.Namespace,
+		0,
+		cache.Indexers{},
+		func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", c.config.Name).String()
+		},
+	).Informer()
+	c.stopCh = make(chan struct{})
+
+	_ = c.informer.SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+		c.logger.Debug("Resource ConfigMap watch failed", zap.Error(err))
+		c.setErr(err)
+	})
+
+	// the registration only fails for a stopped informer
+	c.registration, _ = c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.load,
+		UpdateFunc: func(_, obj any) {
+			c.load(obj)
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+
+			if _, ok := c.configMap(obj); !ok {
+				return
+			}
+
+			c.logger.Info("Resource ConfigMap deleted, no longer collecting its resources")
+			c.setResources(nil)
+		},
+	})
+
+	informer, stopCh := c.informer, c.stopCh
+
+	c.wg.Go(func() {
+		informer.Run(stopCh)
+	})
+}
+
+// stop stops watching the ConfigMap. The loaded resources are kept until the watch is restarted.
+func (c *resourceConfigMap) stop() {
+	c.mu.Lock()
+
+	if c.informer == nil {
+		c.mu.Unlock()
+		return
+	}
+
+	close(c.stopCh)
+	c.informer = nil
+	c.mu.Unlock()
+
+	c.wg.Wait()
+}
+
+// hasSynced reports whether the ConfigMap has been loaded, or its initial list has failed. A missing ConfigMap
+// counts as loaded without any resources.
+func (c *resourceConfigMap) hasSynced() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.informer == nil {
+		return false
+	}
+
+	return c.registration.HasSynced() || c.err != nil
+}
+
+// list returns the resources of the last valid ConfigMap.
+func (c *resourceConfigMap) list() []ResourceConfig {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.resources
+}
+
+// configMap returns the watched ConfigMap, the name is checked to not rely on the field selector of the watch alone.
+func (c *resourceConfigMap) configMap(obj any) (*unstructured.Unstructured, bool) {
+	cm, ok := obj.(*unstructured.Unstructured)
+	if !ok || cm.GetName() != c.config.Name {
+		return nil, false
+	}
+
+	return cm, true
+}
+
+func (c *resourceConfigMap) load(obj any) {
+	cm, ok := c.configMap(obj)
+	if !ok {
+		return
+	}
+
+	data, _, _ := unstructured.NestedString(cm.Object, "data", c.config.Key)
+
+	resources, err := parseResourceConfigMap(data)
+	if err != nil {
+		c.logger.Warn("Invalid resource ConfigMap, keeping the previously loaded resources",
+			zap.Error(err),
+			zap.String("name", c.config.Name),
+			zap.String("namespace", c.config.Namespace),
+			zap.String("key", c.config.Key),
+		)
+
+		return
+	}
+
+	c.logger.Info("Loaded resources from ConfigMap", zap.Int("resources", len(resources)))
+	c.setResources(resources)
+}
+
+func (c *resourceConfigMap) setResources(resources []ResourceConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.resources = resources
+	// an event was delivered, so the watch has recovered from a previous error
+	c.err = nil
+}
+
+func (c *resourceConfigMap) setErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.err = err
+}
+
+// parseResourceConfigMap parses the resources of a ConfigMap key, and validates them with the rules of the receiver config.
+func parseResourceConfigMap(data string) ([]ResourceConfig, error) {
+	retrieved, err := confmap.NewRetrievedFromYAML([]byte(data))
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := retrieved.AsConf()
+	if err != nil {
+		return nil, err
+	}
+
+	var res resourceConfigMapData
+	if err := conf.Unmarshal(&res); err != nil {
+		return nil, err
+	}
+
+	for _, resource := range res.Resources {
+		if err := resource.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return res.Resources, nil
+}
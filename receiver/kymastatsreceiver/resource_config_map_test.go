@@ -0,0 +1,137 @@
+package kymastatsreceiver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseResourceConfigMap(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		expected  []ResourceConfig
+		expectErr bool
+	}{
+		{
+			name: "resources",
+			data: `
+resources:
+  - group: operator.kyma-project.io
+    version: v1alpha1
+    resource: telemetries
+    label_selector: app=telemetry
+  - group: serverless.kyma-project.io
+    version: v1alpha2
+    resource: functions
+    namespaces:
+      exclude: [kube-system]
+    fields:
+      - path: status.replicas
+        metric: kyma.function.replicas
+        type: gauge
+`,
+			expected: []ResourceConfig{
+				{
+					Group:         "operator.kyma-project.io",
+					Version:       "v1alpha1",
+					Resource:      "telemetries",
+					LabelSelector: "app=telemetry",
+				},
+				{
+					Group:      "serverless.kyma-project.io",
+					Version:    "v1alpha2",
+					Resource:   "functions",
+					Namespaces: NamespacesConfig{Exclude: []string{"kube-system"}},
+					Fields: []FieldConfig{
+						{
+							Path:   "status.replicas",
+							Metric: "kyma.function.replicas",
+							Type:   FieldTypeGauge,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "empty",
+		},
+		{
+			name:      "invalid yaml",
+			data:      "resources: [",
+			expectErr: true,
+		},
+		{
+			name: "unknown setting",
+			data: `
+resources:
+  - group: operator.kyma-project.io
+    version: v1alpha1
+    resource: telemetries
+    selector: app=telemetry
+`,
+			expectErr: true,
+		},
+		{
+			name: "invalid resource",
+			data: `
+resources:
+  - group: operator.kyma-project.io
+    version: v1alpha1
+    resource: telemetries
+    namespaces:
+      include: [kyma-system]
+      exclude: [kube-system]
+`,
+			expectErr: true,
+		},
+		{
+			name: "invalid field",
+			data: `
+resources:
+  - group: operator.kyma-project.io
+    version: v1alpha1
+    resource: telemetries
+    fields:
+      - path: status.replicas
+        type: gauge
+`,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resources, err := parseResourceConfigMap(tt.data)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, resources)
+		})
+	}
+}
+
+func TestResourceConfigMap_ClearsWatchError(t *testing.T) {
+	c := newResourceConfigMap(nil, ConfigMapKeyConfig{Namespace: "kyma-system", Name: "kymastats-resources", Key: "resources.yaml"}, zap.NewNop())
+	c.setErr(errors.New("watch failed"))
+
+	c.load(&unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]any{
+			"namespace": "kyma-system",
+			"name":      "kymastats-resources",
+		},
+		"data": map[string]any{
+			"resources.yaml": "resources: []",
+		},
+	}})
+
+	require.NoError(t, c.err)
+}
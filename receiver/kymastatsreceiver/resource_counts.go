@@ -0,0 +1,63 @@
+package kymastatsreceiver
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
+)
+
+type countKey struct {
+	group     string
+	version   string
+	kind      string
+	namespace string
+}
+
+type stateCountKey struct {
+	countKey
+	state string
+}
+
+type conditionCountKey struct {
+	countKey
+	condType string
+	status   string
+}
+
+// resourceCounts aggregates the scraped resources, so that dashboards don't need a series per resource.
+type resourceCounts struct {
+	states     map[stateCountKey]int64
+	conditions map[conditionCountKey]int64
+}
+
+func newResourceCounts() *resourceCounts {
+	return &resourceCounts{
+		states:     make(map[stateCountKey]int64),
+		conditions: make(map[conditionCountKey]int64),
+	}
+}
+
+func (rc *resourceCounts) add(s *resourceStats) {
+	key := countKey{
+		group:     s.group,
+		version:   s.version,
+		kind:      s.kind,
+		namespace: s.namespace,
+	}
+
+	rc.states[stateCountKey{countKey: key, state: s.state}]++
+
+	for _, c := range s.conditions {
+		rc.conditions[conditionCountKey{countKey: key, condType: c.condType, status: c.status}]++
+	}
+}
+
+func (rc *resourceCounts) record(mb *metadata.MetricsBuilder, now pcommon.Timestamp) {
+	for k, count := range rc.states {
+		mb.RecordKymaResourceCountDataPoint(now, count, k.group, k.kind, k.namespace, k.state, k.version)
+	}
+
+	for k, count := range rc.conditions {
+		mb.RecordKymaResourceConditionCountDataPoint(now, count, k.group, k.kind, k.namespace, k.status, k.condType, k.version)
+	}
+}
@@ -0,0 +1,260 @@
+package kymastatsreceiver
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	errWatcherNotStarted = errors.New("resource watcher not started")
+	errWatcherNotSynced  = errors.New("resource watcher not synced")
+)
+
+// watchKey identifies a single informer, resources with different selectors or namespaces need their own informer.
+type watchKey struct {
+	gvr           schema.GroupVersionResource
+	namespace     string
+	labelSelector string
+	fieldSelector string
+}
+
+func newWatchKey(resource ResourceConfig, namespace string) watchKey {
+	return watchKey{
+		gvr:           resource.gvr(),
+		namespace:     namespace,
+		labelSelector: resource.LabelSelector,
+		fieldSelector: resource.fieldSelector(),
+	}
+}
+
+// resourceWatcher keeps a local cache of the configured resources up to date by running
+// a dynamic informer per group-version-resource and namespace.
+type resourceWatcher struct {
+	dynamic dynamic.Interface
+	logger  *zap.Logger
+
+	mu        sync.Mutex
+	resources []ResourceConfig
+	informers map[watchKey]*runningInformer
+	wg        sync.WaitGroup
+
+	// synced is set once the informers have delivered their initial list after the start, informers added later
+	// must not hold back the scrape of the resources that are already synced
+	synced bool
+}
+
+type runningInformer struct {
+	informer informers.GenericInformer
+	stopCh   chan struct{}
+
+	mu sync.Mutex
+	// err is the last error of the informer list and watch requests
+	err error
+}
+
+func (ri *runningInformer) setErr(err error) {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+
+	ri.err = err
+}
+
+func (ri *runningInformer) lastErr() error {
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+
+	return ri.err
+}
+
+// ready reports whether the informer has synced, or failed to do its initial list. A failing informer must not
+// block the scrape of all other resources, for example if a CRD is not installed.
+func (ri *runningInformer) ready() bool {
+	return ri.informer.Informer().HasSynced() || ri.lastErr() != nil
+}
+
+func newResourceWatcher(dynamic dynamic.Interface, resources []ResourceConfig, logger *zap.Logger) *resourceWatcher {
+	return &resourceWatcher{
+		dynamic:   dynamic,
+		resources: resources,
+		logger:    logger,
+	}
+}
+
+// start starts the informers for every configured resource. Calling start on a running watcher is a no-op.
+func (w *resourceWatcher) start() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.informers != nil {
+		return
+	}
+
+	w.informers = make(map[watchKey]*runningInformer)
+	w.sync()
+
+	w.logger.Debug("Started resource watches", zap.Int("informers", len(w.informers)))
+}
+
+// stop stops all informers and drops the local cache. Calling stop on a stopped watcher is a no-op.
+func (w *resourceWatcher) stop() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.informers == nil {
+		return
+	}
+
+	for _, ri := range w.informers {
+		close(ri.stopCh)
+	}
+
+	w.wg.Wait()
+
+	w.informers = nil
+	w.synced = false
+
+	w.logger.Debug("Stopped resource watches")
+}
+
+// update replaces the watched resources. If the watcher is running, informers are started for new resources
+// and stopped for removed ones, while the informers of unchanged resources keep their cache.
+func (w *resourceWatcher) update(resources []ResourceConfig) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.resources = resources
+
+	if w.informers != nil {
+		w.sync()
+	}
+}
+
+// sync reconciles the running informers with the watched resources. It must be called with the mutex held.
+func (w *resourceWatcher) sync() {
+	wanted := make(map[watchKey]bool)
+
+	for _, resource := range w.resources {
+		for _, namespace := range resource.namespaces() {
+			key := newWatchKey(resource, namespace)
+			wanted[key] = true
+
+			if _, ok := w.informers[key]; ok {
+				continue
+			}
+
+			ri := &runningInformer{
+				informer: dynamicinformer.NewFilteredDynamicInformer(
+					w.dynamic,
+					key.gvr,
+					namespace,
+					0,
+					cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+					resource.applyListOptions,
+				),
+				stopCh: make(chan struct{}),
+			}
+			w.informers[key] = ri
+
+			// the handler can only be set before the informer is started, which is always the case here
+			_ = ri.informer.Informer().SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+				w.logger.Debug("Resource watch failed", zap.Error(err), zap.String("resource", key.gvr.String()))
+				ri.setErr(err)
+			})
+
+			w.wg.Add(1)
+
+			go func() {
+				defer w.wg.Done()
+				ri.informer.Informer().Run(ri.stopCh)
+			}()
+		}
+	}
+
+	for key, ri := range w.informers {
+		if !wanted[key] {
+			close(ri.stopCh)
+			delete(w.informers, key)
+
+			w.logger.Debug("Stopped resource watch", zap.String("resource", key.gvr.String()))
+		}
+	}
+}
+
+// hasSynced reports whether the initial list of every informer has been delivered to the local cache, or has failed,
+// since the watcher was started. Once synced, the watcher stays synced until it is stopped, while the informers of
+// resources added later report themselves as not synced when listing their resource.
+func (w *resourceWatcher) hasSynced() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.informers == nil {
+		return false
+	}
+
+	if w.synced {
+		return true
+	}
+
+	for _, ri := range w.informers {
+		if !ri.ready() {
+			return false
+		}
+	}
+
+	w.synced = true
+
+	return true
+}
+
+// list returns the cached objects of the given resource.
+func (w *resourceWatcher) list(resource ResourceConfig) ([]unstructured.Unstructured, error) {
+	var res []unstructured.Unstructured
+
+	for _, namespace := range resource.namespaces() {
+		w.mu.Lock()
+		ri, ok := w.informers[newWatchKey(resource, namespace)]
+		w.mu.Unlock()
+
+		if !ok {
+			return nil, errWatcherNotStarted
+		}
+
+		if !ri.informer.Informer().HasSynced() {
+			if err := ri.lastErr(); err != nil {
+				return nil, err
+			}
+
+			return nil, errWatcherNotSynced
+		}
+
+		objs, err := ri.informer.Lister().List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objs {
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("unexpected object type %T in informer cache", obj)
+			}
+
+			if resource.isNamespaceExcluded(u.GetNamespace()) {
+				continue
+			}
+
+			res = append(res, *u)
+		}
+	}
+
+	return res, nil
+}
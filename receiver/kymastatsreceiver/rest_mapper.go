@@ -0,0 +1,96 @@
+package kymastatsreceiver
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/restmapper"
+)
+
+// restMapperResetInterval limits how often the cached discovery information is reset, so that resources that are
+// not served at all don't query the discovery API on every lookup.
+const restMapperResetInterval = 30 * time.Second
+
+// refreshingRESTMapper maps resources with the cached discovery information, which is reset when a resource can't be
+// mapped, for example because its CRD was installed after the cache was filled. The cache reports itself as fresh
+// once it has been filled, so the deferred mapper alone never picks up new resources.
+type refreshingRESTMapper struct {
+	*restmapper.DeferredDiscoveryRESTMapper
+
+	resetInterval time.Duration
+
+	mu        sync.Mutex
+	lastReset time.Time
+}
+
+func newRefreshingRESTMapper(client discovery.DiscoveryInterface, resetInterval time.Duration) *refreshingRESTMapper {
+	return &refreshingRESTMapper{
+		DeferredDiscoveryRESTMapper: restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client)),
+		resetInterval:               resetInterval,
+	}
+}
+
+func (m *refreshingRESTMapper) KindFor(resource schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	return retryOnNoMatch(m, func() (schema.GroupVersionKind, error) {
+		return m.DeferredDiscoveryRESTMapper.KindFor(resource)
+	})
+}
+
+func (m *refreshingRESTMapper) KindsFor(resource schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	return retryOnNoMatch(m, func() ([]schema.GroupVersionKind, error) {
+		return m.DeferredDiscoveryRESTMapper.KindsFor(resource)
+	})
+}
+
+func (m *refreshingRESTMapper) ResourceFor(input schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	return retryOnNoMatch(m, func() (schema.GroupVersionResource, error) {
+		return m.DeferredDiscoveryRESTMapper.ResourceFor(input)
+	})
+}
+
+func (m *refreshingRESTMapper) ResourcesFor(input schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	return retryOnNoMatch(m, func() ([]schema.GroupVersionResource, error) {
+		return m.DeferredDiscoveryRESTMapper.ResourcesFor(input)
+	})
+}
+
+func (m *refreshingRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	return retryOnNoMatch(m, func() (*meta.RESTMapping, error) {
+		return m.DeferredDiscoveryRESTMapper.RESTMapping(gk, versions...)
+	})
+}
+
+func (m *refreshingRESTMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	return retryOnNoMatch(m, func() ([]*meta.RESTMapping, error) {
+		return m.DeferredDiscoveryRESTMapper.RESTMappings(gk, versions...)
+	})
+}
+
+// reset resets the cached discovery information, unless it has been reset within the reset interval.
+func (m *refreshingRESTMapper) reset() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.lastReset.IsZero() && time.Since(m.lastReset) < m.resetInterval {
+		return false
+	}
+
+	m.lastReset = time.Now()
+	m.Reset()
+
+	return true
+}
+
+// retryOnNoMatch repeats a lookup that found no match once with reset discovery information.
+func retryOnNoMatch[T any](m *refreshingRESTMapper, lookup func() (T, error)) (T, error) {
+	res, err := lookup()
+	if meta.IsNoMatchError(err) && m.reset() {
+		return lookup()
+	}
+
+	return res, err
+}
@@ -0,0 +1,74 @@
+package kymastatsreceiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestRefreshingRESTMapper(t *testing.T) {
+	telemetries := schema.GroupVersionResource{Group: telemetryResourceGroup, Version: telemetryResourceVersion, Resource: "telemetries"}
+
+	tests := []struct {
+		name          string
+		resetInterval time.Duration
+		expectMapped  bool
+	}{
+		{
+			name:         "reset on no match",
+			expectMapped: true,
+		},
+		{
+			name:          "reset rate limited",
+			resetInterval: time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespaces := &metav1.APIResourceList{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "namespaces", Kind: "Namespace"},
+				},
+			}
+
+			client := &discoveryfake.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{namespaces}}}
+			mapper := newRefreshingRESTMapper(client, tt.resetInterval)
+
+			// fills the cache, and resets it as the resource is not served yet
+			_, err := mapper.KindFor(telemetries)
+			require.True(t, meta.IsNoMatchError(err))
+
+			// the CRD is installed after the cache was filled
+			client.Resources = []*metav1.APIResourceList{
+				namespaces,
+				{
+					GroupVersion: telemetries.GroupVersion().String(),
+					APIResources: []metav1.APIResource{
+						{Name: "telemetries", Kind: "Telemetry", Namespaced: true},
+					},
+				},
+			}
+
+			gvk, err := mapper.KindFor(telemetries)
+			if !tt.expectMapped {
+				require.True(t, meta.IsNoMatchError(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, telemetries.GroupVersion().WithKind("Telemetry"), gvk)
+
+			mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+			require.NoError(t, err)
+			require.Equal(t, telemetries, mapping.Resource)
+		})
+	}
+}
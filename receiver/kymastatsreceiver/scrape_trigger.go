@@ -0,0 +1,129 @@
+package kymastatsreceiver
+
+import (
+	"sync"
+	"time"
+)
+
+const scrapeTriggerPollInterval = 100 * time.Millisecond
+
+// scrapeTrigger replaces the ticker of the scraper controller. It ticks at the collection interval, and additionally
+// on demand, for example when the receiver becomes the leader and shouldn't wait for the next collection interval.
+type scrapeTrigger struct {
+	interval time.Duration
+	ch       chan time.Time
+	triggers chan struct{}
+
+	mu     sync.Mutex
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newScrapeTrigger(interval time.Duration) *scrapeTrigger {
+	return &scrapeTrigger{
+		interval: interval,
+		ch:       make(chan time.Time),
+		triggers: make(chan struct{}, 1),
+	}
+}
+
+// C returns the channel the scraper controller reads its ticks from.
+func (t *scrapeTrigger) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *scrapeTrigger) start() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stopCh != nil {
+		return
+	}
+
+	stopCh := make(chan struct{})
+	t.stopCh = stopCh
+
+	t.wg.Go(func() {
+		t.run(stopCh)
+	})
+}
+
+func (t *scrapeTrigger) stop() {
+	t.mu.Lock()
+
+	if t.stopCh == nil {
+		t.mu.Unlock()
+		return
+	}
+
+	close(t.stopCh)
+	t.stopCh = nil
+	t.mu.Unlock()
+
+	t.wg.Wait()
+}
+
+// trigger requests an immediate scrape. Requests before the next scrape are merged into a single scrape.
+func (t *scrapeTrigger) trigger() {
+	select {
+	case t.triggers <- struct{}{}:
+	default:
+	}
+}
+
+// triggerWhenReady requests an immediate scrape as soon as ready returns true. If ready doesn't return true within
+// the collection interval, the request is dropped, as the regular tick scrapes anyway.
+func (t *scrapeTrigger) triggerWhenReady(ready func() bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stopCh == nil {
+		return
+	}
+
+	stopCh := t.stopCh
+
+	t.wg.Go(func() {
+		ticker := time.NewTicker(scrapeTriggerPollInterval)
+		defer ticker.Stop()
+
+		deadline := time.After(t.interval)
+
+		for !ready() {
+			select {
+			case <-ticker.C:
+			case <-deadline:
+				return
+			case <-stopCh:
+				return
+			}
+		}
+
+		t.trigger()
+	})
+}
+
+func (t *scrapeTrigger) run(stopCh chan struct{}) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			t.send(now, stopCh)
+		case <-t.triggers:
+			// keep a full collection interval between the triggered and the next regular scrape
+			ticker.Reset(t.interval)
+			t.send(time.Now(), stopCh)
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (t *scrapeTrigger) send(now time.Time, stopCh chan struct{}) {
+	select {
+	case t.ch <- now:
+	case <-stopCh:
+	}
+}
@@ -0,0 +1,89 @@
+package kymastatsreceiver
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrapeTrigger_Ticks(t *testing.T) {
+	trigger := newScrapeTrigger(50 * time.Millisecond)
+	trigger.start()
+	defer trigger.stop()
+
+	for range 2 {
+		select {
+		case <-trigger.C():
+		case <-time.After(time.Second):
+			require.Fail(t, "no tick within the collection interval")
+		}
+	}
+}
+
+func TestScrapeTrigger_Trigger(t *testing.T) {
+	trigger := newScrapeTrigger(time.Hour)
+	trigger.start()
+	defer trigger.stop()
+
+	trigger.trigger()
+
+	select {
+	case <-trigger.C():
+	case <-time.After(time.Second):
+		require.Fail(t, "triggered scrape not requested")
+	}
+
+	select {
+	case <-trigger.C():
+		require.Fail(t, "unexpected scrape before the collection interval")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestScrapeTrigger_TriggerWhenReady(t *testing.T) {
+	trigger := newScrapeTrigger(time.Hour)
+	trigger.start()
+	defer trigger.stop()
+
+	var ready atomic.Bool
+
+	trigger.triggerWhenReady(ready.Load)
+
+	select {
+	case <-trigger.C():
+		require.Fail(t, "scrape requested before ready")
+	case <-time.After(2 * scrapeTriggerPollInterval):
+	}
+
+	ready.Store(true)
+
+	select {
+	case <-trigger.C():
+	case <-time.After(time.Second):
+		require.Fail(t, "scrape not requested once ready")
+	}
+}
+
+func TestScrapeTrigger_StopUnblocks(t *testing.T) {
+	trigger := newScrapeTrigger(time.Hour)
+	trigger.start()
+
+	// nobody reads the tick, stop must not block on the pending send
+	trigger.trigger()
+	trigger.triggerWhenReady(func() bool { return false })
+
+	stopped := make(chan struct{})
+
+	go func() {
+		trigger.stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		require.Fail(t, "stop blocked")
+	}
+}
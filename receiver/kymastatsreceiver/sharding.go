@@ -0,0 +1,95 @@
+package kymastatsreceiver
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// shard is the subset of resource objects collected by a single replica. Every replica lists all objects, but only
+// processes the objects assigned to its ordinal, so that every object is collected by exactly one replica.
+type shard struct {
+	replicas int
+	ordinal  int
+	key      ShardingKey
+}
+
+// newShard returns the shard of this replica. If no ordinal is configured, it is parsed from the hostname.
+func newShard(config ShardingConfig, hostname string) (*shard, error) {
+	s := &shard{
+		replicas: config.Replicas,
+		key:      config.Key,
+	}
+
+	if config.Ordinal != nil {
+		s.ordinal = *config.Ordinal
+		return s, nil
+	}
+
+	ordinal, err := ordinalFromHostname(hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	if ordinal >= config.Replicas {
+		return nil, fmt.Errorf("sharding: ordinal %d of hostname %q out of range, must be between 0 and %d", ordinal, hostname, config.Replicas-1)
+	}
+
+	s.ordinal = ordinal
+
+	return s, nil
+}
+
+// ordinalFromHostname parses the trailing number of a hostname, like the pod name of a StatefulSet.
+func ordinalFromHostname(hostname string) (int, error) {
+	i := strings.LastIndex(hostname, "-")
+
+	ordinal, err := strconv.Atoi(hostname[i+1:])
+	if i < 0 || err != nil || ordinal < 0 {
+		return 0, fmt.Errorf("sharding: ordinal not configured and hostname %q doesn't end with an ordinal", hostname)
+	}
+
+	return ordinal, nil
+}
+
+// owns reports whether the object is assigned to this replica.
+func (s *shard) owns(obj *unstructured.Unstructured) bool {
+	return jumpHash(hashKey(s.objectKey(obj)), s.replicas) == s.ordinal
+}
+
+func (s *shard) objectKey(obj *unstructured.Unstructured) string {
+	if s.key == ShardingKeyNamespace && obj.GetNamespace() != "" {
+		return obj.GetNamespace()
+	}
+
+	if uid := obj.GetUID(); uid != "" {
+		return string(uid)
+	}
+
+	// objects always have a UID when retrieved from the API server, fall back to the object name to be safe
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+func hashKey(key string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+
+	return h.Sum64()
+}
+
+// jumpHash implements the jump consistent hash of Lamping and Veach, which maps a key to one of the buckets.
+// When the number of buckets grows, only a minimal share of the keys moves to the new buckets.
+func jumpHash(key uint64, buckets int) int {
+	var b, j int64 = -1, 0
+
+	for j < int64(buckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+
+	return int(b)
+}
@@ -0,0 +1,156 @@
+package kymastatsreceiver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestNewShard(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          ShardingConfig
+		hostname        string
+		expectedOrdinal int
+		expectErr       bool
+	}{
+		{
+			name:            "configured ordinal",
+			config:          ShardingConfig{Replicas: 3, Ordinal: ptr.To(2)},
+			hostname:        "otel-collector-0",
+			expectedOrdinal: 2,
+		},
+		{
+			name:            "ordinal from hostname",
+			config:          ShardingConfig{Replicas: 3},
+			hostname:        "otel-collector-1",
+			expectedOrdinal: 1,
+		},
+		{
+			name:      "hostname without ordinal",
+			config:    ShardingConfig{Replicas: 3},
+			hostname:  "otel-collector-7d4b9c-x2x8k",
+			expectErr: true,
+		},
+		{
+			name:      "hostname without dash",
+			config:    ShardingConfig{Replicas: 3},
+			hostname:  "localhost",
+			expectErr: true,
+		},
+		{
+			name:      "ordinal from hostname out of range",
+			config:    ShardingConfig{Replicas: 3},
+			hostname:  "otel-collector-3",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := newShard(tt.config, tt.hostname)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOrdinal, s.ordinal)
+		})
+	}
+}
+
+func TestShard_Owns(t *testing.T) {
+	const replicas = 3
+
+	newObject := func(namespace, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		obj.SetUID(types.UID(namespace + "-" + name + "-uid"))
+
+		return obj
+	}
+
+	var objects []*unstructured.Unstructured
+
+	for ns := range 10 {
+		for name := range 10 {
+			objects = append(objects, newObject(fmt.Sprintf("ns-%d", ns), fmt.Sprintf("obj-%d", name)))
+		}
+	}
+
+	// cluster-scoped objects
+	for name := range 10 {
+		objects = append(objects, newObject("", fmt.Sprintf("obj-%d", name)))
+	}
+
+	for _, key := range []ShardingKey{ShardingKeyUID, ShardingKeyNamespace} {
+		t.Run(string(key), func(t *testing.T) {
+			owners := make(map[string]int)
+			namespaceOwners := make(map[string]map[int]bool)
+
+			for ordinal := range replicas {
+				s, err := newShard(ShardingConfig{Replicas: replicas, Ordinal: ptr.To(ordinal), Key: key}, "")
+				require.NoError(t, err)
+
+				for _, obj := range objects {
+					if !s.owns(obj) {
+						continue
+					}
+
+					owners[string(obj.GetUID())]++
+
+					if namespaceOwners[obj.GetNamespace()] == nil {
+						namespaceOwners[obj.GetNamespace()] = make(map[int]bool)
+					}
+
+					namespaceOwners[obj.GetNamespace()][ordinal] = true
+				}
+			}
+
+			// every object is owned by exactly one replica
+			require.Len(t, owners, len(objects))
+
+			for uid, count := range owners {
+				require.Equal(t, 1, count, "object %s", uid)
+			}
+
+			if key == ShardingKeyNamespace {
+				for namespace, ordinals := range namespaceOwners {
+					if namespace != "" {
+						require.Len(t, ordinals, 1, "namespace %s", namespace)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestJumpHash(t *testing.T) {
+	const keys = 1000
+
+	counts := make([]int, 5)
+
+	for i := range keys {
+		bucket := jumpHash(hashKey(fmt.Sprintf("key-%d", i)), len(counts))
+		require.GreaterOrEqual(t, bucket, 0)
+		require.Less(t, bucket, len(counts))
+
+		counts[bucket]++
+
+		// growing the number of buckets only moves keys to the new bucket
+		grown := jumpHash(hashKey(fmt.Sprintf("key-%d", i)), len(counts)+1)
+		if grown != bucket {
+			require.Equal(t, len(counts), grown)
+		}
+	}
+
+	for _, count := range counts {
+		require.InDelta(t, keys/len(counts), count, float64(keys/len(counts)/2))
+	}
+}
@@ -0,0 +1,185 @@
+package kymastatsreceiver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
+)
+
+// objectKey identifies a single resource object across scrapes.
+type objectKey struct {
+	group     string
+	version   string
+	kind      string
+	namespace string
+	name      string
+}
+
+// objectSnapshot is the status of a resource object at the time of a scrape.
+type objectSnapshot struct {
+	// gvr is the resource the object was listed from
+	gvr        schema.GroupVersionResource
+	uid        string
+	state      string
+	hasState   bool
+	conditions map[string]condition
+}
+
+func newObjectSnapshot(s *resourceStats) objectSnapshot {
+	snapshot := objectSnapshot{
+		gvr:        schema.GroupVersionResource{Group: s.group, Version: s.version, Resource: s.plural},
+		uid:        s.uid,
+		state:      s.state,
+		hasState:   s.hasState,
+		conditions: make(map[string]condition, len(s.conditions)),
+	}
+
+	for _, c := range s.conditions {
+		snapshot.conditions[c.condType] = c
+	}
+
+	return snapshot
+}
+
+func (s *resourceStats) key() objectKey {
+	return objectKey{
+		group:     s.group,
+		version:   s.version,
+		kind:      s.kind,
+		namespace: s.namespace,
+		name:      s.name,
+	}
+}
+
+// scrapeLogs emits a log record for every state or condition change since the previous scrape.
+// The first scrape only records the status of all resources, as well as the first scrape of a newly created resource.
+// If entity events are enabled, every resource object is additionally emitted as an entity state event, and objects
+// that disappeared since the previous scrape as an entity delete event.
+func (ks *kymaScraper) scrapeLogs(ctx context.Context) (plog.Logs, error) {
+	if !ks.shouldScrape.Load() {
+		// the leader might have changed the status in the meantime, start over once leading again
+		ks.snapshots = nil
+		return plog.NewLogs(), nil
+	}
+
+	now := pcommon.NewTimestampFromTime(time.Now())
+	ld := plog.NewLogs()
+	snapshots := make(map[objectKey]objectSnapshot)
+	entities := plog.NewLogRecordSlice()
+
+	failures, synced, err := ks.collect(ctx, func(s *resourceStats) {
+		current := newObjectSnapshot(s)
+		snapshots[s.key()] = current
+
+		if ks.config.EntityEvents.Enabled {
+			appendEntityState(entities, now, ks.config.CollectionInterval, s)
+		}
+
+		previous, found := ks.snapshots[s.key()]
+		if !found {
+			return
+		}
+
+		records := plog.NewLogRecordSlice()
+		appendStateTransition(records, now, previous, current)
+		appendConditionTransitions(records, now, previous, s.conditions)
+
+		if records.Len() == 0 {
+			return
+		}
+
+		rl := ld.ResourceLogs().AppendEmpty()
+		ks.resource(s).MoveTo(rl.Resource())
+
+		sl := rl.ScopeLogs().AppendEmpty()
+		sl.Scope().SetName(metadata.ScopeName)
+		sl.Scope().SetVersion(ks.buildInfo.Version)
+		records.MoveAndAppendTo(sl.LogRecords())
+	})
+	if err != nil && !scrapererror.IsPartialScrapeError(err) {
+		return plog.Logs{}, err
+	}
+
+	if !synced {
+		return plog.NewLogs(), nil
+	}
+
+	for key, snapshot := range ks.snapshots {
+		if _, ok := snapshots[key]; ok {
+			continue
+		}
+
+		if failures[snapshot.gvr] > 0 {
+			// keep the status of the resources that couldn't be listed, to detect their changes once they can be listed again
+			snapshots[key] = snapshot
+		} else if ks.config.EntityEvents.Enabled {
+			appendEntityDelete(entities, now, key, snapshot)
+		}
+	}
+
+	ks.emitEntityEvents(ld, entities)
+
+	ks.snapshots = snapshots
+
+	// this condition tries to avoid duplicated logs when just losing leadership
+	if !ks.shouldScrape.Load() {
+		return plog.NewLogs(), nil
+	}
+
+	return ld, err
+}
+
+func appendStateTransition(records plog.LogRecordSlice, now pcommon.Timestamp, previous, current objectSnapshot) {
+	if previous.state == current.state && previous.hasState == current.hasState {
+		return
+	}
+
+	lr := records.AppendEmpty()
+	lr.SetTimestamp(now)
+	lr.SetObservedTimestamp(now)
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.SetSeverityText(plog.SeverityNumberInfo.String())
+	lr.Body().SetStr(fmt.Sprintf("State changed from %q to %q", previous.state, current.state))
+
+	lr.Attributes().PutStr("previous_state", previous.state)
+	lr.Attributes().PutStr("state", current.state)
+}
+
+func appendConditionTransitions(records plog.LogRecordSlice, now pcommon.Timestamp, previous objectSnapshot, conditions []condition) {
+	for _, c := range conditions {
+		prev := previous.conditions[c.condType]
+		if prev.status == c.status && prev.reason == c.reason {
+			continue
+		}
+
+		lr := records.AppendEmpty()
+		lr.SetObservedTimestamp(now)
+		lr.SetTimestamp(now)
+
+		if !c.lastTransitionTime.IsZero() {
+			lr.SetTimestamp(pcommon.NewTimestampFromTime(c.lastTransitionTime))
+		}
+
+		lr.SetSeverityNumber(plog.SeverityNumberInfo)
+		lr.SetSeverityText(plog.SeverityNumberInfo.String())
+		lr.Body().SetStr(fmt.Sprintf("Condition %s changed from %q (%s) to %q (%s)", c.condType, prev.status, prev.reason, c.status, c.reason))
+
+		attrs := lr.Attributes()
+		attrs.PutStr("type", c.condType)
+		attrs.PutStr("previous_status", prev.status)
+		attrs.PutStr("status", c.status)
+		attrs.PutStr("previous_reason", prev.reason)
+		attrs.PutStr("reason", c.reason)
+
+		if c.message != "" {
+			attrs.PutStr("message", c.message)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package kymastatsreceiver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/kyma-project/opentelemetry-collector-components/receiver/kymastatsreceiver/internal/metadata"
+)
+
+func TestScrapeLogs_Transitions(t *testing.T) {
+	resources := []ResourceConfig{
+		{
+			Group:    telemetryResourceGroup,
+			Version:  telemetryResourceVersion,
+			Resource: "telemetries",
+		},
+	}
+
+	telemetry := newUnstructuredObject("Telemetry", "telemetry", "default")
+	unstructured.SetNestedMap(telemetry, map[string]any{
+		"state": "Ready",
+		"conditions": []any{
+			map[string]any{
+				"type":   "TelemetryHealthy",
+				"status": "True",
+				"reason": "AllFine",
+			},
+		},
+	}, "status")
+
+	dynamic := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			resources[0].gvr(): "TelemetryList",
+		},
+		&unstructured.Unstructured{Object: telemetry},
+	)
+
+	r, err := newKymaLogsScraper(
+		Config{
+			MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+			Resources:            resources,
+		},
+		dynamic,
+		newTestRESTMapper(),
+		receivertest.NewNopSettings(metadata.Type),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Start(t.Context(), componenttest.NewNopHost()))
+
+	// the first scrape only records the current status
+	ld, err := r.ScrapeLogs(t.Context())
+	require.NoError(t, err)
+	require.Zero(t, ld.LogRecordCount())
+
+	// an unchanged status doesn't emit anything
+	ld, err = r.ScrapeLogs(t.Context())
+	require.NoError(t, err)
+	require.Zero(t, ld.LogRecordCount())
+
+	unstructured.SetNestedMap(telemetry, map[string]any{
+		"state": "Warning",
+		"conditions": []any{
+			map[string]any{
+				"type":               "TelemetryHealthy",
+				"status":             "False",
+				"reason":             "ComponentsNotReady",
+				"message":            "Some components are not ready",
+				"lastTransitionTime": "2025-01-02T03:04:05Z",
+			},
+		},
+	}, "status")
+
+	_, err = dynamic.Resource(resources[0].gvr()).Namespace(telemetryResourceNamespace).Update(t.Context(), &unstructured.Unstructured{Object: telemetry}, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	ld, err = r.ScrapeLogs(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, 1, ld.ResourceLogs().Len())
+
+	name, _ := ld.ResourceLogs().At(0).Resource().Attributes().Get("k8s.resource.name")
+	require.Equal(t, "default", name.Str())
+
+	records := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	require.Equal(t, 2, records.Len())
+
+	require.Equal(t, map[string]any{
+		"previous_state": "Ready",
+		"state":          "Warning",
+	}, records.At(0).Attributes().AsRaw())
+
+	require.Equal(t, map[string]any{
+		"type":            "TelemetryHealthy",
+		"previous_status": "True",
+		"status":          "False",
+		"previous_reason": "AllFine",
+		"reason":          "ComponentsNotReady",
+		"message":         "Some components are not ready",
+	}, records.At(1).Attributes().AsRaw())
+	require.Equal(t, plog.SeverityNumberInfo, records.At(1).SeverityNumber())
+	require.Equal(t, "2025-01-02T03:04:05Z", records.At(1).Timestamp().AsTime().UTC().Format("2006-01-02T15:04:05Z"))
+}